@@ -1,7 +1,20 @@
 package configs
 
 import (
+	"fmt"
 	"os"
+	"time"
+)
+
+const (
+	defaultRetentionInterval    = time.Hour
+	defaultTombstoneGracePeriod = 7 * 24 * time.Hour
+)
+
+const (
+	StoreBackendEtcd  = "etcd"
+	StoreBackendInMem = "inmem"
+	StoreBackendBolt  = "bolt"
 )
 
 type Config struct {
@@ -15,6 +28,13 @@ type Config struct {
 	webhooksAddress   string
 	webhookUrl        string
 	tokenKey          string
+	storeBackend      string
+	boltPath          string
+	secretsKeyFile    string
+	reviewPolicy      string
+	retentionPolicy   string
+	retentionInterval time.Duration
+	tombstoneGrace    time.Duration
 }
 
 func (c *Config) NatsAddress() string {
@@ -57,7 +77,64 @@ func (c *Config) TokenKey() string {
 	return c.tokenKey
 }
 
+func (c *Config) StoreBackend() string {
+	if c.storeBackend == "" {
+		return StoreBackendEtcd
+	}
+	return c.storeBackend
+}
+
+func (c *Config) BoltPath() string {
+	return c.boltPath
+}
+
+// SecretsKeyFile is the path of the key-encryption key used for secret params
+func (c *Config) SecretsKeyFile() string {
+	return c.secretsKeyFile
+}
+
+// ReviewPolicy lists the namespaces whose new versions need approvals, as org/namespace=approvals pairs separated by commas
+func (c *Config) ReviewPolicy() string {
+	return c.reviewPolicy
+}
+
+// RetentionPolicy lists the retention rules of namespaces, as org/namespace=rule pairs separated by commas
+func (c *Config) RetentionPolicy() string {
+	return c.retentionPolicy
+}
+
+// RetentionInterval is the time between two collections of expired versions
+func (c *Config) RetentionInterval() time.Duration {
+	return c.retentionInterval
+}
+
+// TombstoneGracePeriod is how long a deleted version can be restored before its tombstone is purged
+func (c *Config) TombstoneGracePeriod() time.Duration {
+	return c.tombstoneGrace
+}
+
 func NewFromEnv() (*Config, error) {
+	retentionInterval := defaultRetentionInterval
+	if interval := os.Getenv("RETENTION_INTERVAL"); interval != "" {
+		parsed, err := time.ParseDuration(interval)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("invalid RETENTION_INTERVAL %q, expected a positive duration", interval)
+		}
+		retentionInterval = parsed
+	}
+	tombstoneGrace := defaultTombstoneGracePeriod
+	if grace := os.Getenv("TOMBSTONE_GRACE_PERIOD"); grace != "" {
+		parsed, err := time.ParseDuration(grace)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("invalid TOMBSTONE_GRACE_PERIOD %q, expected a duration", grace)
+		}
+		tombstoneGrace = parsed
+	}
+	storeBackend := os.Getenv("STORE_BACKEND")
+	boltPath := os.Getenv("BOLT_PATH")
+	if storeBackend == StoreBackendBolt && boltPath == "" {
+		return nil, fmt.Errorf("BOLT_PATH is required when STORE_BACKEND is %q", StoreBackendBolt)
+	}
 	return &Config{
 		natsAddress:       os.Getenv("NATS_ADDRESS"),
 		magnetarAddress:   os.Getenv("MAGNETAR_ADDRESS"),
@@ -69,5 +146,12 @@ func NewFromEnv() (*Config, error) {
 		webhooksAddress:   os.Getenv("WEBHOOK_ADDRESS"),
 		webhookUrl:        os.Getenv("WEBHOOK_URL"),
 		tokenKey:          os.Getenv("SECRET_KEY"),
+		storeBackend:      storeBackend,
+		boltPath:          boltPath,
+		secretsKeyFile:    os.Getenv("SECRETS_KEY_FILE"),
+		reviewPolicy:      os.Getenv("REVIEW_POLICY"),
+		retentionPolicy:   os.Getenv("RETENTION_POLICY"),
+		retentionInterval: retentionInterval,
+		tombstoneGrace:    tombstoneGrace,
 	}, nil
 }
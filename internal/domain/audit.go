@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionPut     AuditAction = "put"
+	AuditActionDelete  AuditAction = "delete"
+	AuditActionPlace   AuditAction = "place"
+	AuditActionApprove AuditAction = "approve"
+	AuditActionReject  AuditAction = "reject"
+	AuditActionRestore AuditAction = "restore"
+	// AuditActionPurge is the removal of a tombstone after its grace period
+	AuditActionPurge AuditAction = "purge"
+	// AuditActionTaskStatus is a placement task status reported by an agent through the webhooks
+	AuditActionTaskStatus AuditAction = "task_status"
+)
+
+func GetAuditActionValues() []AuditAction {
+	return []AuditAction{
+		AuditActionPut,
+		AuditActionDelete,
+		AuditActionPlace,
+		AuditActionApprove,
+		AuditActionReject,
+		AuditActionRestore,
+		AuditActionPurge,
+		AuditActionTaskStatus,
+	}
+}
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+// AuditActorAgent is the actor of task status updates, agents report them through the webhooks without a token
+const AuditActorAgent = "agent"
+
+// AuditActorRetention is the actor of deletes and purges made by the retention collector
+const AuditActorRetention = "retention"
+
+// AuditEvent is an append-only record of an attempted mutation of a config
+type AuditEvent struct {
+	Id         string
+	Actor      string
+	Action     AuditAction
+	ConfigType string
+	Config     ConfigRef
+	Outcome    AuditOutcome
+	// ErrType and Message are empty if the mutation succeeded
+	ErrType string
+	Message string
+	// Detail describes the mutation further if the action alone doesn't, like the status of a placement task
+	Detail    string
+	Timestamp time.Time
+}
+
+func NewAuditEvent(id, actor string, action AuditAction, configType string, config ConfigRef, detail string, err *Error, timestamp time.Time) AuditEvent {
+	event := AuditEvent{
+		Id:         id,
+		Actor:      actor,
+		Action:     action,
+		ConfigType: configType,
+		Config:     config,
+		Outcome:    AuditOutcomeSuccess,
+		Detail:     detail,
+		Timestamp:  timestamp.UTC(),
+	}
+	if err != nil {
+		event.Outcome = AuditOutcomeFailure
+		event.ErrType = err.ErrType().String()
+		event.Message = err.Message()
+	}
+	return event
+}
+
+// AuditFilter selects the audit events of an organization, empty fields match every event
+type AuditFilter struct {
+	Org       Org
+	Namespace string
+	Actor     string
+	Action    AuditAction
+	// From and To are unix timestamps (seconds), the lower bound is inclusive and the upper bound exclusive, zero means unbounded
+	From      int64
+	To        int64
+	PageSize  int
+	PageToken string
+}
+
+func (f AuditFilter) Validate() *Error {
+	if f.Org == "" {
+		return NewError(ErrTypeSchemaInvalid, "organization must be set")
+	}
+	if f.Action != "" && !slices.Contains(GetAuditActionValues(), f.Action) {
+		return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown audit action: %s", f.Action))
+	}
+	if f.PageSize < 0 {
+		return NewError(ErrTypeSchemaInvalid, "page size can't be negative")
+	}
+	return nil
+}
+
+func (f AuditFilter) Matches(event AuditEvent) bool {
+	if event.Config.Org != f.Org {
+		return false
+	}
+	if f.Namespace != "" && event.Config.Namespace != f.Namespace {
+		return false
+	}
+	if f.Actor != "" && event.Actor != f.Actor {
+		return false
+	}
+	if f.Action != "" && event.Action != f.Action {
+		return false
+	}
+	if f.From != 0 && event.Timestamp.Unix() < f.From {
+		return false
+	}
+	if f.To != 0 && event.Timestamp.Unix() >= f.To {
+		return false
+	}
+	return true
+}
+
+// ListAuditEvents applies the filter to the events under the listed prefix and returns them oldest first,
+// key returns the store key of an event which is used for ordering ties and page tokens
+func ListAuditEvents(events []AuditEvent, filter AuditFilter, prefix string, key func(AuditEvent) string) ([]AuditEvent, string, *Error) {
+	filtered := make([]AuditEvent, 0, len(events))
+	for _, event := range events {
+		if filter.Matches(event) {
+			filtered = append(filtered, event)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if !filtered[i].Timestamp.Equal(filtered[j].Timestamp) {
+			return filtered[i].Timestamp.Before(filtered[j].Timestamp)
+		}
+		return strings.Compare(key(filtered[i]), key(filtered[j])) < 0
+	})
+
+	start := 0
+	if filter.PageToken != "" {
+		tokenKey, err := DecodePageToken(filter.PageToken, prefix)
+		if err != nil {
+			return nil, "", err
+		}
+		index := slices.IndexFunc(filtered, func(event AuditEvent) bool {
+			return key(event) == tokenKey
+		})
+		if index < 0 {
+			return nil, "", NewError(ErrTypeSchemaInvalid, "page token is no longer valid")
+		}
+		start = index + 1
+	}
+	filtered = filtered[start:]
+
+	if filter.PageSize == 0 || len(filtered) <= filter.PageSize {
+		return filtered, "", nil
+	}
+	page := filtered[:filter.PageSize]
+	return page, EncodePageToken(key(page[len(page)-1])), nil
+}
+
+type AuditStore interface {
+	// Append stores a new event, existing events are never changed
+	Append(ctx context.Context, event AuditEvent) *Error
+	List(ctx context.Context, filter AuditFilter) ([]AuditEvent, string, *Error)
+}
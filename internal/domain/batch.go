@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// ConfigBatch holds new versions of standalone configs and config groups that are created together
+type ConfigBatch struct {
+	StandaloneConfigs []*StandaloneConfig
+	ConfigGroups      []*ConfigGroup
+}
+
+func (b ConfigBatch) Len() int {
+	return len(b.StandaloneConfigs) + len(b.ConfigGroups)
+}
+
+// BatchItemError is the error of a single batch item,
+// the index points into the standalone configs or the config groups of the batch depending on the config type
+type BatchItemError struct {
+	ConfigType string
+	Index      int
+	Name       string
+	Version    string
+	Err        *Error
+}
+
+func NewBatchItemError(config Config, index int, err *Error) BatchItemError {
+	return BatchItemError{
+		ConfigType: config.Type(),
+		Index:      index,
+		Name:       config.Name(),
+		Version:    config.Version(),
+		Err:        err,
+	}
+}
+
+// VersionExistsError reports a batch item whose version already exists
+func VersionExistsError(config Config, index int) BatchItemError {
+	kind := "standalone config"
+	if config.Type() == ConfTypeGroup {
+		kind = "config group"
+	}
+	return NewBatchItemError(config, index, NewError(ErrTypeVersionExists, fmt.Sprintf("%s (Org: %s, name: %s, version: %s) already exists", kind, config.Org(), config.Name(), config.Version())))
+}
+
+// ConfigBatchStore creates all versions of a batch in a single transaction,
+// if any of the versions already exists nothing is created and the conflicting items are returned.
+// Stores whose transactions are limited reject larger batches as invalid
+type ConfigBatchStore interface {
+	PutBatch(ctx context.Context, batch ConfigBatch) ([]BatchItemError, *Error)
+}
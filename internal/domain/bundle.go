@@ -0,0 +1,495 @@
+package domain
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const bundleFormatVersion = 1
+
+type BundleFormat string
+
+const (
+	BundleFormatYAML  BundleFormat = "yaml"
+	BundleFormatJSON  BundleFormat = "json"
+	BundleFormatTarGz BundleFormat = "tar.gz"
+)
+
+func GetBundleFormatValues() []BundleFormat {
+	return []BundleFormat{
+		BundleFormatYAML,
+		BundleFormatJSON,
+		BundleFormatTarGz,
+	}
+}
+
+type ImportConflictMode string
+
+const (
+	// ImportConflictSkip keeps the existing version and skips the bundled one
+	ImportConflictSkip ImportConflictMode = "skip"
+	// ImportConflictFail aborts the import before anything is written
+	ImportConflictFail ImportConflictMode = "fail"
+	// ImportConflictIdentical accepts an existing version only if it is identical to the bundled one,
+	// versions are immutable so nothing is ever overwritten
+	ImportConflictIdentical ImportConflictMode = "identical"
+)
+
+func GetImportConflictModeValues() []ImportConflictMode {
+	return []ImportConflictMode{
+		ImportConflictSkip,
+		ImportConflictFail,
+		ImportConflictIdentical,
+	}
+}
+
+// Bundle is a portable snapshot of all config versions of a namespace,
+// secret params are held in plaintext so the bundle can be imported into an instance with a different key
+type Bundle struct {
+	FormatVersion     int                      `json:"formatVersion" yaml:"formatVersion"`
+	Org               string                   `json:"org" yaml:"org"`
+	Namespace         string                   `json:"namespace" yaml:"namespace"`
+	StandaloneConfigs []BundleStandaloneConfig `json:"standaloneConfigs" yaml:"standaloneConfigs"`
+	ConfigGroups      []BundleConfigGroup      `json:"configGroups" yaml:"configGroups"`
+}
+
+type BundleParam struct {
+	Key    string    `json:"key" yaml:"key"`
+	Value  string    `json:"value" yaml:"value"`
+	Type   ParamType `json:"type,omitempty" yaml:"type,omitempty"`
+	Secret bool      `json:"secret,omitempty" yaml:"secret,omitempty"`
+}
+
+// BundleConfigRef references the base of an overlay, an empty namespace is the bundled namespace
+type BundleConfigRef struct {
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Name      string `json:"name" yaml:"name"`
+	Version   string `json:"version" yaml:"version"`
+}
+
+type BundleStandaloneConfig struct {
+	Name          string            `json:"name" yaml:"name"`
+	Version       string            `json:"version" yaml:"version"`
+	CreatedAt     string            `json:"createdAt" yaml:"createdAt"`
+	Params        []BundleParam     `json:"params" yaml:"params"`
+	RemovedParams []string          `json:"removedParams,omitempty" yaml:"removedParams,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Annotations   map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Base          *BundleConfigRef  `json:"base,omitempty" yaml:"base,omitempty"`
+}
+
+type BundleParamSet struct {
+	Name          string        `json:"name" yaml:"name"`
+	Params        []BundleParam `json:"params" yaml:"params"`
+	RemovedParams []string      `json:"removedParams,omitempty" yaml:"removedParams,omitempty"`
+}
+
+type BundleConfigGroup struct {
+	Name        string            `json:"name" yaml:"name"`
+	Version     string            `json:"version" yaml:"version"`
+	CreatedAt   string            `json:"createdAt" yaml:"createdAt"`
+	ParamSets   []BundleParamSet  `json:"paramSets" yaml:"paramSets"`
+	Labels      map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	Base        *BundleConfigRef  `json:"base,omitempty" yaml:"base,omitempty"`
+}
+
+func NewBundle(org Org, namespace string) *Bundle {
+	return &Bundle{
+		FormatVersion:     bundleFormatVersion,
+		Org:               string(org),
+		Namespace:         namespace,
+		StandaloneConfigs: make([]BundleStandaloneConfig, 0),
+		ConfigGroups:      make([]BundleConfigGroup, 0),
+	}
+}
+
+// AddStandaloneConfig adds the stored (not resolved) form of the config, secrets must already be decrypted
+func (b *Bundle) AddStandaloneConfig(config *StandaloneConfig) {
+	b.StandaloneConfigs = append(b.StandaloneConfigs, BundleStandaloneConfig{
+		Name:          config.Name(),
+		Version:       config.Version(),
+		CreatedAt:     config.CreatedAtUTC().Format(time.RFC3339),
+		Params:        toBundleParams(config.paramSet),
+		RemovedParams: config.paramSet.removals,
+		Labels:        config.labels,
+		Annotations:   config.annotations,
+		Base:          toBundleConfigRef(config.base, b.Namespace),
+	})
+}
+
+// AddConfigGroup adds the stored (not resolved) form of the config, secrets must already be decrypted
+func (b *Bundle) AddConfigGroup(config *ConfigGroup) {
+	paramSets := make([]BundleParamSet, 0, len(config.paramSets))
+	for _, ps := range config.paramSets {
+		paramSets = append(paramSets, BundleParamSet{
+			Name:          ps.name,
+			Params:        toBundleParams(ps),
+			RemovedParams: ps.removals,
+		})
+	}
+	b.ConfigGroups = append(b.ConfigGroups, BundleConfigGroup{
+		Name:        config.Name(),
+		Version:     config.Version(),
+		CreatedAt:   config.CreatedAtUTC().Format(time.RFC3339),
+		ParamSets:   paramSets,
+		Labels:      config.labels,
+		Annotations: config.annotations,
+		Base:        toBundleConfigRef(config.base, b.Namespace),
+	})
+}
+
+// ToStandaloneConfigs returns the bundled configs placed into the target namespace,
+// bases of overlays are ordered before the overlays
+func (b *Bundle) ToStandaloneConfigs(org Org, namespace string) ([]*StandaloneConfig, *Error) {
+	configs := make([]*StandaloneConfig, 0, len(b.StandaloneConfigs))
+	for _, bundled := range b.StandaloneConfigs {
+		createdAt, err := parseBundleTime(bundled.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		paramSet := fromBundleParams(bundled.Name, bundled.Params)
+		paramSet.SetRemovals(bundled.RemovedParams)
+		config := InitStandaloneConfig(org, namespace, bundled.Version, createdAt, *paramSet)
+		config.SetLabels(bundled.Labels)
+		config.SetAnnotations(bundled.Annotations)
+		config.SetBase(fromBundleConfigRef(bundled.Base, org, namespace))
+		configs = append(configs, config)
+	}
+	sortBasesFirst(configs)
+	return configs, nil
+}
+
+// ToConfigGroups returns the bundled configs placed into the target namespace,
+// bases of overlays are ordered before the overlays
+func (b *Bundle) ToConfigGroups(org Org, namespace string) ([]*ConfigGroup, *Error) {
+	configs := make([]*ConfigGroup, 0, len(b.ConfigGroups))
+	for _, bundled := range b.ConfigGroups {
+		createdAt, err := parseBundleTime(bundled.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		paramSets := make([]NamedParamSet, 0, len(bundled.ParamSets))
+		for _, bundledParamSet := range bundled.ParamSets {
+			paramSet := fromBundleParams(bundledParamSet.Name, bundledParamSet.Params)
+			paramSet.SetRemovals(bundledParamSet.RemovedParams)
+			paramSets = append(paramSets, *paramSet)
+		}
+		config := InitConfigGroup(org, namespace, bundled.Name, bundled.Version, createdAt, paramSets)
+		config.SetLabels(bundled.Labels)
+		config.SetAnnotations(bundled.Annotations)
+		config.SetBase(fromBundleConfigRef(bundled.Base, org, namespace))
+		configs = append(configs, config)
+	}
+	sortBasesFirst(configs)
+	return configs, nil
+}
+
+func EncodeBundle(bundle *Bundle, format BundleFormat) ([]byte, *Error) {
+	var encoded []byte
+	var err error
+	switch format {
+	case "", BundleFormatYAML:
+		encoded, err = yaml.Marshal(bundle)
+	case BundleFormatJSON:
+		encoded, err = json.MarshalIndent(bundle, "", "  ")
+	case BundleFormatTarGz:
+		encoded, err = encodeTarGzBundle(bundle)
+	default:
+		return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown bundle format: %s", format))
+	}
+	if err != nil {
+		return nil, NewError(ErrTypeMarshalSS, err.Error())
+	}
+	return encoded, nil
+}
+
+func DecodeBundle(encoded []byte, format BundleFormat) (*Bundle, *Error) {
+	bundle := &Bundle{}
+	var err error
+	switch format {
+	case "", BundleFormatYAML:
+		err = yaml.Unmarshal(encoded, bundle)
+	case BundleFormatJSON:
+		err = json.Unmarshal(encoded, bundle)
+	case BundleFormatTarGz:
+		bundle, err = decodeTarGzBundle(encoded)
+	default:
+		return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown bundle format: %s", format))
+	}
+	if err != nil {
+		return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("invalid bundle: %s", err.Error()))
+	}
+	if bundle.FormatVersion != bundleFormatVersion {
+		return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unsupported bundle format version: %d", bundle.FormatVersion))
+	}
+	return bundle, nil
+}
+
+// SameStandaloneConfig reports whether two versions hold the same content, creation times are ignored
+func SameStandaloneConfig(a, b *StandaloneConfig) bool {
+	return sameConfigBase(a.ConfigBase, b.ConfigBase) && sameParamSet(a.paramSet, b.paramSet)
+}
+
+// SameConfigGroup reports whether two versions hold the same content, creation times are ignored
+func SameConfigGroup(a, b *ConfigGroup) bool {
+	if !sameConfigBase(a.ConfigBase, b.ConfigBase) || len(a.paramSets) != len(b.paramSets) {
+		return false
+	}
+	for _, ps := range a.paramSets {
+		cmp, err := b.ParamSet(ps.name)
+		if err != nil || !sameParamSet(ps, cmp) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameConfigBase(a, b ConfigBase) bool {
+	if (a.base == nil) != (b.base == nil) || (a.base != nil && *a.base != *b.base) {
+		return false
+	}
+	return maps.Equal(a.Labels(), b.Labels()) && maps.Equal(a.Annotations(), b.Annotations())
+}
+
+func sameParamSet(a, b NamedParamSet) bool {
+	if len(a.params) != len(b.params) || len(a.Diff(b)) > 0 {
+		return false
+	}
+	for key := range a.params {
+		if a.IsSecret(key) != b.IsSecret(key) || normalizeParamType(a.types[key]) != normalizeParamType(b.types[key]) {
+			return false
+		}
+	}
+	removalsA, removalsB := slices.Clone(a.Removals()), slices.Clone(b.Removals())
+	slices.Sort(removalsA)
+	slices.Sort(removalsB)
+	return slices.Equal(removalsA, removalsB)
+}
+
+func toBundleParams(ps NamedParamSet) []BundleParam {
+	keys := make([]string, 0, len(ps.params))
+	for key := range ps.params {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	params := make([]BundleParam, 0, len(keys))
+	for _, key := range keys {
+		params = append(params, BundleParam{
+			Key:    key,
+			Value:  ps.params[key],
+			Type:   ps.types[key],
+			Secret: ps.IsSecret(key),
+		})
+	}
+	return params
+}
+
+func fromBundleParams(name string, bundled []BundleParam) *NamedParamSet {
+	params := make(map[string]string)
+	types := make(map[string]ParamType)
+	secrets := make(map[string]bool)
+	for _, param := range bundled {
+		params[param.Key] = param.Value
+		if param.Type != "" {
+			types[param.Key] = param.Type
+		}
+		if param.Secret {
+			secrets[param.Key] = true
+		}
+	}
+	paramSet := NewTypedParamSet(name, params, types)
+	paramSet.SetSecrets(secrets)
+	return paramSet
+}
+
+func toBundleConfigRef(ref *ConfigRef, namespace string) *BundleConfigRef {
+	if ref == nil {
+		return nil
+	}
+	bundled := &BundleConfigRef{Name: ref.Name, Version: ref.Version}
+	if ref.Namespace != namespace {
+		bundled.Namespace = ref.Namespace
+	}
+	return bundled
+}
+
+func fromBundleConfigRef(bundled *BundleConfigRef, org Org, namespace string) *ConfigRef {
+	if bundled == nil {
+		return nil
+	}
+	ref := &ConfigRef{Org: org, Namespace: bundled.Namespace, Name: bundled.Name, Version: bundled.Version}
+	if ref.Namespace == "" {
+		ref.Namespace = namespace
+	}
+	return ref
+}
+
+func parseBundleTime(value string) (int64, *Error) {
+	if value == "" {
+		return time.Now().Unix(), nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("invalid creation time %s: %s", value, err.Error()))
+	}
+	return parsed.Unix(), nil
+}
+
+// sortBasesFirst orders configs by the depth of their overlay chain within the slice
+func sortBasesFirst[T interface {
+	Config
+	Base() *ConfigRef
+}](configs []T) {
+	index := make(map[ConfigRef]T)
+	for _, config := range configs {
+		index[ConfigRefOf(config)] = config
+	}
+	depths := make(map[ConfigRef]int)
+	for _, config := range configs {
+		depth := 0
+		for current := config; current.Base() != nil && depth < MaxOverlayDepth; depth++ {
+			base, ok := index[*current.Base()]
+			if !ok {
+				break
+			}
+			current = base
+		}
+		depths[ConfigRefOf(config)] = depth
+	}
+	slices.SortStableFunc(configs, func(a, b T) int {
+		return depths[ConfigRefOf(a)] - depths[ConfigRefOf(b)]
+	})
+}
+
+const bundleManifestFile = "manifest.yaml"
+
+const (
+	// maxBundleEntrySize limits the decompressed size of a single file of a tar.gz bundle
+	maxBundleEntrySize = 1 << 20
+	// maxBundleSize limits the decompressed size of all files of a tar.gz bundle together
+	maxBundleSize = 64 << 20
+)
+
+// encodeTarGzBundle writes the manifest and one yaml file per config version
+func encodeTarGzBundle(bundle *Bundle) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	write := func(name string, value any) error {
+		content, err := yaml.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), ModTime: time.Now()}); err != nil {
+			return err
+		}
+		_, err = tw.Write(content)
+		return err
+	}
+
+	manifest := struct {
+		FormatVersion int    `yaml:"formatVersion"`
+		Org           string `yaml:"org"`
+		Namespace     string `yaml:"namespace"`
+	}{
+		FormatVersion: bundle.FormatVersion,
+		Org:           bundle.Org,
+		Namespace:     bundle.Namespace,
+	}
+	if err := write(bundleManifestFile, manifest); err != nil {
+		return nil, err
+	}
+	for _, config := range bundle.StandaloneConfigs {
+		if err := write(path.Join(ConfTypeStandalone, config.Name, config.Version+".yaml"), config); err != nil {
+			return nil, err
+		}
+	}
+	for _, config := range bundle.ConfigGroups {
+		if err := write(path.Join(ConfTypeGroup, config.Name, config.Version+".yaml"), config); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeTarGzBundle(encoded []byte) (*Bundle, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(encoded))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+
+	bundle := &Bundle{}
+	manifestFound := false
+	total := 0
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(io.LimitReader(tr, maxBundleEntrySize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(content) > maxBundleEntrySize {
+			return nil, fmt.Errorf("%s is larger than %d bytes", header.Name, maxBundleEntrySize)
+		}
+		total += len(content)
+		if total > maxBundleSize {
+			return nil, fmt.Errorf("bundle is larger than %d bytes", maxBundleSize)
+		}
+		switch {
+		case header.Name == bundleManifestFile:
+			manifestFound = true
+			manifest := Bundle{}
+			if err := yaml.Unmarshal(content, &manifest); err != nil {
+				return nil, err
+			}
+			bundle.FormatVersion = manifest.FormatVersion
+			bundle.Org = manifest.Org
+			bundle.Namespace = manifest.Namespace
+		case strings.HasPrefix(header.Name, ConfTypeStandalone+"/"):
+			config := BundleStandaloneConfig{}
+			if err := yaml.Unmarshal(content, &config); err != nil {
+				return nil, fmt.Errorf("%s: %w", header.Name, err)
+			}
+			bundle.StandaloneConfigs = append(bundle.StandaloneConfigs, config)
+		case strings.HasPrefix(header.Name, ConfTypeGroup+"/"):
+			config := BundleConfigGroup{}
+			if err := yaml.Unmarshal(content, &config); err != nil {
+				return nil, fmt.Errorf("%s: %w", header.Name, err)
+			}
+			bundle.ConfigGroups = append(bundle.ConfigGroups, config)
+		}
+	}
+	if !manifestFound {
+		return nil, errors.New("bundle has no manifest")
+	}
+	return bundle, nil
+}
@@ -24,14 +24,24 @@ type Config interface {
 	Version() string
 	CreatedAtUnixSec() int64
 	CreatedAtUTC() time.Time
+	Labels() map[string]string
+	Annotations() map[string]string
 	Type() string
 }
 
 type ConfigBase struct {
-	org       Org
-	namespace string
-	version   string
-	createdAt int64
+	org         Org
+	namespace   string
+	version     string
+	createdAt   int64
+	labels      map[string]string
+	annotations map[string]string
+	base        *ConfigRef
+	promoted    *Provenance
+	contentHash string
+	duplicateOf string
+	draft       *Draft
+	deleted     *Tombstone
 }
 
 func (c *ConfigBase) Org() Org {
@@ -58,9 +68,91 @@ func (c *ConfigBase) CreatedAtUTC() time.Time {
 	return time.Unix(c.createdAt, 0).UTC()
 }
 
+func (c *ConfigBase) Labels() map[string]string {
+	if c.labels == nil {
+		return make(map[string]string)
+	}
+	return c.labels
+}
+
+func (c *ConfigBase) SetLabels(labels map[string]string) {
+	c.labels = labels
+}
+
+func (c *ConfigBase) Annotations() map[string]string {
+	if c.annotations == nil {
+		return make(map[string]string)
+	}
+	return c.annotations
+}
+
+func (c *ConfigBase) SetAnnotations(annotations map[string]string) {
+	c.annotations = annotations
+}
+
+// Base returns the config this config overlays, nil if it isn't an overlay
+func (c *ConfigBase) Base() *ConfigRef {
+	return c.base
+}
+
+func (c *ConfigBase) SetBase(base *ConfigRef) {
+	c.base = base
+}
+
+// PromotedFrom returns the config version this config was promoted from, nil if it was put directly
+func (c *ConfigBase) PromotedFrom() *Provenance {
+	return c.promoted
+}
+
+func (c *ConfigBase) SetPromotedFrom(promoted *Provenance) {
+	c.promoted = promoted
+}
+
+// ContentHash returns the hash of the effective content computed when the version was put
+func (c *ConfigBase) ContentHash() string {
+	return c.contentHash
+}
+
+func (c *ConfigBase) SetContentHash(contentHash string) {
+	c.contentHash = contentHash
+}
+
+// DuplicateOf returns the version whose content this version repeats, empty if the content is new or wasn't compared
+func (c *ConfigBase) DuplicateOf() string {
+	return c.duplicateOf
+}
+
+func (c *ConfigBase) SetDuplicateOf(version string) {
+	c.duplicateOf = version
+}
+
+// Draft returns the review state of the version, nil if the version isn't a draft
+func (c *ConfigBase) Draft() *Draft {
+	return c.draft
+}
+
+func (c *ConfigBase) SetDraft(draft *Draft) {
+	c.draft = draft
+}
+
+// Deleted returns the tombstone of a deleted version, it is nil for versions that aren't deleted
+func (c *ConfigBase) Deleted() *Tombstone {
+	return c.deleted
+}
+
+func (c *ConfigBase) SetDeleted(deleted *Tombstone) {
+	c.deleted = deleted
+}
+
 type NamedParamSet struct {
-	name   string
-	params map[string]string
+	name    string
+	params  map[string]string
+	types   map[string]ParamType
+	secrets map[string]bool
+	// removals are the base params dropped by an overlay
+	removals []string
+	// origins are the layers the params of an effective overlay come from
+	origins map[string]string
 }
 
 func NewParamSet(name string, params map[string]string) *NamedParamSet {
@@ -70,6 +162,14 @@ func NewParamSet(name string, params map[string]string) *NamedParamSet {
 	}
 }
 
+func NewTypedParamSet(name string, params map[string]string, types map[string]ParamType) *NamedParamSet {
+	return &NamedParamSet{
+		name:   name,
+		params: params,
+		types:  types,
+	}
+}
+
 func (ps NamedParamSet) Name() string {
 	return ps.name
 }
@@ -78,6 +178,135 @@ func (ps NamedParamSet) ParamSet() map[string]string {
 	return ps.params
 }
 
+// ParamTypes returns the declared types of typed params, untyped params are strings
+func (ps NamedParamSet) ParamTypes() map[string]ParamType {
+	if ps.types == nil {
+		return make(map[string]ParamType)
+	}
+	return ps.types
+}
+
+func (ps NamedParamSet) ParamType(key string) ParamType {
+	if paramType, ok := ps.types[key]; ok && paramType != "" {
+		return paramType
+	}
+	return ParamTypeString
+}
+
+func (ps *NamedParamSet) SetSecrets(secrets map[string]bool) {
+	ps.secrets = secrets
+}
+
+// Secrets returns the keys of params marked as secret
+func (ps NamedParamSet) Secrets() map[string]bool {
+	if ps.secrets == nil {
+		return make(map[string]bool)
+	}
+	return ps.secrets
+}
+
+func (ps NamedParamSet) IsSecret(key string) bool {
+	return ps.secrets[key]
+}
+
+func (ps NamedParamSet) HasSecrets() bool {
+	for key, secret := range ps.secrets {
+		if _, ok := ps.params[key]; ok && secret {
+			return true
+		}
+	}
+	return false
+}
+
+func (ps *NamedParamSet) SetRemovals(removals []string) {
+	ps.removals = removals
+}
+
+func (ps NamedParamSet) Removals() []string {
+	if ps.removals == nil {
+		return make([]string, 0)
+	}
+	return ps.removals
+}
+
+// Origin returns the config (org/namespace/name/version) the param value comes from,
+// it is only known for params of a resolved overlay
+func (ps NamedParamSet) Origin(key string) string {
+	return ps.origins[key]
+}
+
+// MapParams returns a copy of the param set with fn applied to all param values
+func (ps NamedParamSet) MapParams(fn func(key, value string) (string, *Error)) (NamedParamSet, *Error) {
+	params := make(map[string]string, len(ps.params))
+	for key, value := range ps.params {
+		mapped, err := fn(key, value)
+		if err != nil {
+			return NamedParamSet{}, NewError(err.ErrType(), fmt.Sprintf("param %s: %s", key, err.Message()))
+		}
+		params[key] = mapped
+	}
+	mapped := ps
+	mapped.params = params
+	return mapped, nil
+}
+
+// WithSecrets returns a copy of the param set with the listed params also marked as secret,
+// keys of params the param set doesn't have are ignored
+func (ps NamedParamSet) WithSecrets(keys map[string]bool) NamedParamSet {
+	secrets := make(map[string]bool, len(ps.secrets)+len(keys))
+	for key, secret := range ps.secrets {
+		secrets[key] = secret
+	}
+	for key, secret := range keys {
+		if _, ok := ps.params[key]; ok && secret {
+			secrets[key] = true
+		}
+	}
+	marked := ps
+	marked.secrets = secrets
+	return marked
+}
+
+// MapSecrets returns a copy of the param set with fn applied to the values of secret params
+func (ps NamedParamSet) MapSecrets(fn func(value string) (string, *Error)) (NamedParamSet, *Error) {
+	return ps.MapParams(func(key, value string) (string, *Error) {
+		if !ps.IsSecret(key) {
+			return value, nil
+		}
+		return fn(value)
+	})
+}
+
+func (ps NamedParamSet) Validate() *Error {
+	if err := validateParamPaths(ps.params); err != nil {
+		return err
+	}
+	for key, paramType := range ps.types {
+		if !paramType.IsValid() {
+			return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param %s has unknown type %s", key, paramType))
+		}
+		value, ok := ps.params[key]
+		if !ok {
+			continue
+		}
+		if _, err := ParseParamValue(paramType, value); err != nil {
+			return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param %s is not a valid %s: %s", key, paramType, err.Error()))
+		}
+	}
+	return nil
+}
+
+// YAMLValues returns the params by their full (dotted) paths with values converted to their declared types,
+// it is the form schemas are validated against, Tree returns the nested form
+func (ps NamedParamSet) YAMLValues() map[string]any {
+	values := make(map[string]any)
+	for key, value := range ps.params {
+		values[key] = YAMLValue(ps.ParamType(key), value)
+	}
+	return values
+}
+
+// Diff compares params by their full (flattened) paths
 func (ps NamedParamSet) Diff(cmp NamedParamSet) []Diff {
 	diffs := make([]Diff, 0)
 
@@ -95,7 +324,7 @@ func (ps NamedParamSet) Diff(cmp NamedParamSet) []Diff {
 			}
 
 			diffs = append(diffs, newDiff)
-		} else if ok && labelN != labelL {
+		} else if ok && !paramValuesEqual(ps.ParamType(key), labelN, cmp.ParamType(key), labelL) {
 			newDiff = Replace{
 				Key: key,
 				New: labelN,
@@ -157,6 +386,52 @@ func (c *StandaloneConfig) ParamSet() map[string]string {
 	return c.paramSet.params
 }
 
+func (c *StandaloneConfig) ParamTypes() map[string]ParamType {
+	return c.paramSet.ParamTypes()
+}
+
+func (c *StandaloneConfig) NamedParamSet() NamedParamSet {
+	return c.paramSet
+}
+
+func (c *StandaloneConfig) ValidateParams() *Error {
+	return c.paramSet.Validate()
+}
+
+func (c *StandaloneConfig) HasSecrets() bool {
+	return c.paramSet.HasSecrets()
+}
+
+// MapParams returns a copy of the config with fn applied to all param values
+func (c *StandaloneConfig) MapParams(fn func(key, value string) (string, *Error)) (*StandaloneConfig, *Error) {
+	paramSet, err := c.paramSet.MapParams(fn)
+	if err != nil {
+		return nil, err
+	}
+	return &StandaloneConfig{
+		ConfigBase: c.ConfigBase,
+		paramSet:   paramSet,
+	}, nil
+}
+
+// WithSecrets returns a copy of the config with the listed params also marked as secret
+func (c *StandaloneConfig) WithSecrets(keys map[string]bool) *StandaloneConfig {
+	return &StandaloneConfig{
+		ConfigBase: c.ConfigBase,
+		paramSet:   c.paramSet.WithSecrets(keys),
+	}
+}
+
+// MapSecrets returns a copy of the config with fn applied to the values of secret params
+func (c *StandaloneConfig) MapSecrets(fn func(value string) (string, *Error)) (*StandaloneConfig, *Error) {
+	return c.MapParams(func(key, value string) (string, *Error) {
+		if !c.paramSet.IsSecret(key) {
+			return value, nil
+		}
+		return fn(value)
+	})
+}
+
 func (c *StandaloneConfig) Diff(cmp *StandaloneConfig) []Diff {
 	return c.paramSet.Diff(cmp.paramSet)
 }
@@ -213,6 +488,67 @@ func (c *ConfigGroup) ParamSet(name string) (NamedParamSet, *Error) {
 	return NamedParamSet{}, NewError(ErrTypeNotFound, fmt.Sprintf("param set (name: %s) not found", name))
 }
 
+func (c *ConfigGroup) ValidateParams() *Error {
+	for _, ps := range c.paramSets {
+		if err := ps.Validate(); err != nil {
+			return NewError(err.ErrType(), fmt.Sprintf("param set %s: %s", ps.name, err.Message()))
+		}
+	}
+	return nil
+}
+
+func (c *ConfigGroup) HasSecrets() bool {
+	for _, ps := range c.paramSets {
+		if ps.HasSecrets() {
+			return true
+		}
+	}
+	return false
+}
+
+// MapParams returns a copy of the config with fn applied to all param values
+func (c *ConfigGroup) MapParams(fn func(paramSet, key, value string) (string, *Error)) (*ConfigGroup, *Error) {
+	paramSets := make([]NamedParamSet, 0, len(c.paramSets))
+	for _, ps := range c.paramSets {
+		mapped, err := ps.MapParams(func(key, value string) (string, *Error) {
+			return fn(ps.name, key, value)
+		})
+		if err != nil {
+			return nil, NewError(err.ErrType(), fmt.Sprintf("param set %s: %s", ps.name, err.Message()))
+		}
+		paramSets = append(paramSets, mapped)
+	}
+	return &ConfigGroup{
+		ConfigBase: c.ConfigBase,
+		name:       c.name,
+		paramSets:  paramSets,
+	}, nil
+}
+
+// WithSecrets returns a copy of the config with the listed params of each param set also marked as secret
+func (c *ConfigGroup) WithSecrets(keys map[string]map[string]bool) *ConfigGroup {
+	paramSets := make([]NamedParamSet, 0, len(c.paramSets))
+	for _, ps := range c.paramSets {
+		paramSets = append(paramSets, ps.WithSecrets(keys[ps.name]))
+	}
+	return &ConfigGroup{
+		ConfigBase: c.ConfigBase,
+		name:       c.name,
+		paramSets:  paramSets,
+	}
+}
+
+// MapSecrets returns a copy of the config with fn applied to the values of secret params
+func (c *ConfigGroup) MapSecrets(fn func(value string) (string, *Error)) (*ConfigGroup, *Error) {
+	return c.MapParams(func(paramSet, key, value string) (string, *Error) {
+		ps, err := c.ParamSet(paramSet)
+		if err != nil || !ps.IsSecret(key) {
+			return value, nil
+		}
+		return fn(value)
+	})
+}
+
 func (c *ConfigGroup) Diff(cmp *ConfigGroup) map[string][]Diff {
 	diffs := make(map[string][]Diff)
 
@@ -256,14 +592,56 @@ func (c *ConfigGroup) Type() string {
 
 type StandaloneConfigStore interface {
 	Put(ctx context.Context, config *StandaloneConfig) *Error
+	// PutIfLatest stores a new version like Put, as long as latest is still the latest version of the config
+	PutIfLatest(ctx context.Context, config *StandaloneConfig, latest string) *Error
 	Get(ctx context.Context, org Org, namespace, name, version string) (*StandaloneConfig, *Error)
-	List(ctx context.Context, org Org, namespace string) ([]*StandaloneConfig, *Error)
-	Delete(ctx context.Context, org Org, namespace, name, version string) (*StandaloneConfig, *Error)
+	// GetAt reads the version as it was at the store revision, zero reads the current state
+	GetAt(ctx context.Context, org Org, namespace, name, version string, revision int64) (*StandaloneConfig, *Error)
+	List(ctx context.Context, org Org, namespace string, opts ListOptions) ([]*StandaloneConfig, string, *Error)
+	// ListByOrg returns the versions of all namespaces of the organization
+	ListByOrg(ctx context.Context, org Org) ([]*StandaloneConfig, *Error)
+	// ListVersions returns the versions that aren't drafts, so version queries never resolve to a draft
+	ListVersions(ctx context.Context, org Org, namespace, name string) ([]string, *Error)
+	ListVersionsAt(ctx context.Context, org Org, namespace, name string, revision int64) ([]string, *Error)
+	// Revision resolves a point in time to the store revision it was read from, zero for the current state
+	Revision(ctx context.Context, at PointInTime) (int64, *Error)
+	// Delete moves the version to its tombstone, a previous tombstone of the same version is replaced
+	Delete(ctx context.Context, org Org, namespace, name, version string, tombstone *Tombstone) (*StandaloneConfig, *Error)
+	ListDeleted(ctx context.Context, org Org, namespace string) ([]*StandaloneConfig, *Error)
+	// Restore moves a deleted version back, unless the version has been created again in the meantime
+	Restore(ctx context.Context, org Org, namespace, name, version string) (*StandaloneConfig, *Error)
+	// PurgeDeleted removes the tombstones of all organizations whose grace period is over,
+	// stores keeping past revisions also drop what they recorded about the compacted ones
+	PurgeDeleted(ctx context.Context, gracePeriod time.Duration, now time.Time) ([]*StandaloneConfig, *Error)
+	// ReviewDraft applies the review to a draft, a discarded version is moved to the tombstone like a deleted one
+	ReviewDraft(ctx context.Context, org Org, namespace, name, version string, review DraftReview, tombstone *Tombstone) (*StandaloneConfig, *Error)
+	Watch(ctx context.Context, org Org, namespace, name string, fromRevision int64) (<-chan ConfigEvent[*StandaloneConfig], *Error)
 }
 
 type ConfigGroupStore interface {
 	Put(ctx context.Context, config *ConfigGroup) *Error
+	// PutIfLatest stores a new version like Put, as long as latest is still the latest version of the config
+	PutIfLatest(ctx context.Context, config *ConfigGroup, latest string) *Error
 	Get(ctx context.Context, org Org, namespace, name, version string) (*ConfigGroup, *Error)
-	List(ctx context.Context, org Org, namespace string) ([]*ConfigGroup, *Error)
-	Delete(ctx context.Context, org Org, namespace, name, version string) (*ConfigGroup, *Error)
+	// GetAt reads the version as it was at the store revision, zero reads the current state
+	GetAt(ctx context.Context, org Org, namespace, name, version string, revision int64) (*ConfigGroup, *Error)
+	List(ctx context.Context, org Org, namespace string, opts ListOptions) ([]*ConfigGroup, string, *Error)
+	// ListByOrg returns the versions of all namespaces of the organization
+	ListByOrg(ctx context.Context, org Org) ([]*ConfigGroup, *Error)
+	// ListVersions returns the versions that aren't drafts, so version queries never resolve to a draft
+	ListVersions(ctx context.Context, org Org, namespace, name string) ([]string, *Error)
+	ListVersionsAt(ctx context.Context, org Org, namespace, name string, revision int64) ([]string, *Error)
+	// Revision resolves a point in time to the store revision it was read from, zero for the current state
+	Revision(ctx context.Context, at PointInTime) (int64, *Error)
+	// Delete moves the version to its tombstone, a previous tombstone of the same version is replaced
+	Delete(ctx context.Context, org Org, namespace, name, version string, tombstone *Tombstone) (*ConfigGroup, *Error)
+	ListDeleted(ctx context.Context, org Org, namespace string) ([]*ConfigGroup, *Error)
+	// Restore moves a deleted version back, unless the version has been created again in the meantime
+	Restore(ctx context.Context, org Org, namespace, name, version string) (*ConfigGroup, *Error)
+	// PurgeDeleted removes the tombstones of all organizations whose grace period is over,
+	// stores keeping past revisions also drop what they recorded about the compacted ones
+	PurgeDeleted(ctx context.Context, gracePeriod time.Duration, now time.Time) ([]*ConfigGroup, *Error)
+	// ReviewDraft applies the review to a draft, a discarded version is moved to the tombstone like a deleted one
+	ReviewDraft(ctx context.Context, org Org, namespace, name, version string, review DraftReview, tombstone *Tombstone) (*ConfigGroup, *Error)
+	Watch(ctx context.Context, org Org, namespace, name string, fromRevision int64) (<-chan ConfigEvent[*ConfigGroup], *Error)
 }
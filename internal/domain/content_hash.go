@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"slices"
+	"sort"
+)
+
+const contentHashPrefix = "sha256:"
+
+type DuplicateContentPolicy string
+
+const (
+	// DuplicateContentAllow stores a version without comparing it to the latest one
+	DuplicateContentAllow DuplicateContentPolicy = "allow"
+	// DuplicateContentFlag stores a version and marks it as a duplicate of the latest version if their content is identical
+	DuplicateContentFlag DuplicateContentPolicy = "flag"
+	// DuplicateContentReject refuses a version whose content is identical to the latest version, the comparison isn't
+	// part of the write so identical versions put at the same time can still both be stored
+	DuplicateContentReject DuplicateContentPolicy = "reject"
+)
+
+func GetDuplicateContentPolicyValues() []DuplicateContentPolicy {
+	return []DuplicateContentPolicy{
+		DuplicateContentAllow,
+		DuplicateContentFlag,
+		DuplicateContentReject,
+	}
+}
+
+func ParseDuplicateContentPolicy(policy string) (DuplicateContentPolicy, *Error) {
+	if policy == "" {
+		return DuplicateContentAllow, nil
+	}
+	if !slices.Contains(GetDuplicateContentPolicyValues(), DuplicateContentPolicy(policy)) {
+		return "", NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown duplicate content policy: %s", policy))
+	}
+	return DuplicateContentPolicy(policy), nil
+}
+
+// ContentHash returns a SHA-256 hash of the params of the param sets which doesn't depend on their order,
+// types and secrecy are part of the content. Secrets are hashed by their keyed digest, so the hash changes with them
+// but can't be used to guess them, a nil secretDigest hashes them in plaintext
+func ContentHash(paramSets []NamedParamSet, secretDigest func(value string) (string, *Error)) (string, *Error) {
+	sorted := slices.Clone(paramSets)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].name < sorted[j].name
+	})
+	h := sha256.New()
+	for _, paramSet := range sorted {
+		writeHashField(h, paramSet.name)
+		writeHashField(h, fmt.Sprint(len(paramSet.params)))
+		keys := make([]string, 0, len(paramSet.params))
+		for key := range paramSet.params {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			writeHashField(h, key)
+			writeHashField(h, string(paramSet.types[key]))
+			writeHashField(h, fmt.Sprint(paramSet.IsSecret(key)))
+			value := paramSet.params[key]
+			if paramSet.IsSecret(key) && secretDigest != nil {
+				digest, err := secretDigest(value)
+				if err != nil {
+					return "", err
+				}
+				value = digest
+			}
+			writeHashField(h, value)
+		}
+	}
+	return contentHashPrefix + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeHashField writes a length prefixed field, so different params can't produce the same input
+func writeHashField(h hash.Hash, field string) {
+	h.Write([]byte(fmt.Sprintf("%d:%s", len(field), field)))
+}
+
+// ComputeContentHash hashes the (effective, decrypted) params of the config
+func (c *StandaloneConfig) ComputeContentHash(secretDigest func(value string) (string, *Error)) (string, *Error) {
+	return ContentHash([]NamedParamSet{c.paramSet}, secretDigest)
+}
+
+// ComputeContentHash hashes the (effective, decrypted) params of all param sets of the config
+func (c *ConfigGroup) ComputeContentHash(secretDigest func(value string) (string, *Error)) (string, *Error) {
+	return ContentHash(c.paramSets, secretDigest)
+}
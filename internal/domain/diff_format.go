@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+)
+
+type DiffFormat string
+
+const (
+	// DiffFormatList returns the diffs of the params, it is the default format
+	DiffFormatList DiffFormat = "list"
+	// DiffFormatJSONPatch returns an RFC 6902 JSON Patch which turns the reference into the compared config
+	DiffFormatJSONPatch DiffFormat = "json_patch"
+	// DiffFormatUnified returns a unified diff of both configs rendered as YAML
+	DiffFormatUnified DiffFormat = "unified"
+)
+
+func GetDiffFormatValues() []DiffFormat {
+	return []DiffFormat{
+		DiffFormatList,
+		DiffFormatJSONPatch,
+		DiffFormatUnified,
+	}
+}
+
+func ParseDiffFormat(format string) (DiffFormat, *Error) {
+	if format == "" {
+		return DiffFormatList, nil
+	}
+	if !slices.Contains(GetDiffFormatValues(), DiffFormat(format)) {
+		return "", NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown diff format: %s", format))
+	}
+	return DiffFormat(format), nil
+}
+
+// JSONPatchOperation is an RFC 6902 operation on the document the unified diff renders, the nested param tree
+// of a standalone config and an object which maps the param set names to their trees for a config group
+type JSONPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+// MarshalJSON leaves the value out of remove operations only, a false, zero or null value is still a value
+func (op JSONPatchOperation) MarshalJSON() ([]byte, error) {
+	if op.Op == "remove" {
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{Op: op.Op, Path: op.Path})
+	}
+	type operation JSONPatchOperation
+	return json.Marshal(operation(op))
+}
+
+// StandaloneConfigDiff is the comparison of a config with a reference config, both are effective configs
+// whose secrets are in the same form as in the diffs
+type StandaloneConfigDiff struct {
+	Reference *StandaloneConfig
+	Target    *StandaloneConfig
+	Diffs     []Diff
+	// RedactedReplacements is set if a secret param changed and the secrets are redacted,
+	// the rendered formats can't show the change so they aren't rendered at all
+	RedactedReplacements bool
+}
+
+func (d StandaloneConfigDiff) JSONPatch() ([]JSONPatchOperation, *Error) {
+	if d.RedactedReplacements {
+		return nil, redactedReplacementsError(DiffFormatJSONPatch)
+	}
+	return checkedJSONPatch(d.Reference.NamedParamSet().Tree(), d.Target.NamedParamSet().Tree())
+}
+
+func (d StandaloneConfigDiff) Unified() (string, *Error) {
+	if d.RedactedReplacements {
+		return "", redactedReplacementsError(DiffFormatUnified)
+	}
+	return unifiedDiff(ConfigRefOf(d.Reference), d.Reference.NamedParamSet().Tree(), ConfigRefOf(d.Target), d.Target.NamedParamSet().Tree())
+}
+
+// ConfigGroupDiff is the comparison of a config group with a reference config group, both are effective configs
+// whose secrets are in the same form as in the diffs
+type ConfigGroupDiff struct {
+	Reference *ConfigGroup
+	Target    *ConfigGroup
+	Diffs     map[string][]Diff
+	// RedactedReplacements is set if a secret param changed and the secrets are redacted,
+	// the rendered formats can't show the change so they aren't rendered at all
+	RedactedReplacements bool
+}
+
+// JSONPatch adds and removes whole param sets, so that the patch also applies to param sets without params
+func (d ConfigGroupDiff) JSONPatch() ([]JSONPatchOperation, *Error) {
+	if d.RedactedReplacements {
+		return nil, redactedReplacementsError(DiffFormatJSONPatch)
+	}
+	return checkedJSONPatch(paramSetTrees(d.Reference), paramSetTrees(d.Target))
+}
+
+func (d ConfigGroupDiff) Unified() (string, *Error) {
+	if d.RedactedReplacements {
+		return "", redactedReplacementsError(DiffFormatUnified)
+	}
+	return unifiedDiff(ConfigRefOf(d.Reference), paramSetTrees(d.Reference), ConfigRefOf(d.Target), paramSetTrees(d.Target))
+}
+
+func paramSetTrees(group *ConfigGroup) map[string]any {
+	trees := make(map[string]any, len(group.paramSets))
+	for _, paramSet := range group.paramSets {
+		trees[paramSet.name] = paramSet.Tree()
+	}
+	return trees
+}
+
+func redactedReplacementsError(format DiffFormat) *Error {
+	return NewError(ErrTypeUnauthorized, fmt.Sprintf("secret params changed, the %s format needs the permission to reveal them", format))
+}
+
+// escapeJSONPointer escapes a reference token of a JSON Pointer (RFC 6901)
+func escapeJSONPointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+func unifiedDiff(referenceRef ConfigRef, reference any, targetRef ConfigRef, target any) (string, *Error) {
+	referenceYAML, err := yaml.Marshal(reference)
+	if err != nil {
+		return "", NewError(ErrTypeMarshalSS, err.Error())
+	}
+	targetYAML, err := yaml.Marshal(target)
+	if err != nil {
+		return "", NewError(ErrTypeMarshalSS, err.Error())
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSuffix(string(referenceYAML), "\n")),
+		B:        difflib.SplitLines(strings.TrimSuffix(string(targetYAML), "\n")),
+		FromFile: referenceRef.String(),
+		ToFile:   targetRef.String(),
+		Context:  3,
+	})
+	if err != nil {
+		return "", NewError(ErrTypeInternal, err.Error())
+	}
+	return diff, nil
+}
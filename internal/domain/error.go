@@ -10,6 +10,8 @@ const (
 	ErrTypeUnauthorized
 	ErrTypeInternal
 	ErrTypeSchemaInvalid
+	// ErrTypeFailedPrecondition reports an operation that isn't allowed in the current state of the config
+	ErrTypeFailedPrecondition
 )
 
 type Error struct {
@@ -31,3 +33,26 @@ func (e Error) ErrType() ErrorType {
 func (e Error) Message() string {
 	return e.message
 }
+
+func (t ErrorType) String() string {
+	switch t {
+	case ErrTypeMarshalSS:
+		return "marshal"
+	case ErrTypeDb:
+		return "db"
+	case ErrTypeNotFound:
+		return "not_found"
+	case ErrTypeVersionExists:
+		return "version_exists"
+	case ErrTypeUnauthorized:
+		return "unauthorized"
+	case ErrTypeInternal:
+		return "internal"
+	case ErrTypeSchemaInvalid:
+		return "schema_invalid"
+	case ErrTypeFailedPrecondition:
+		return "failed_precondition"
+	default:
+		return "unknown"
+	}
+}
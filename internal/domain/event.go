@@ -0,0 +1,19 @@
+package domain
+
+type ConfigEventType string
+
+const (
+	ConfigEventCreated ConfigEventType = "created"
+	// ConfigEventUpdated is a change of a stored version, watches report the approval of a draft as its creation instead
+	ConfigEventUpdated ConfigEventType = "updated"
+	ConfigEventDeleted ConfigEventType = "deleted"
+)
+
+// ConfigEvent is a change of a stored config, the revision can be used to resume watching after it,
+// if Err is set the watch has failed and no further events will be sent
+type ConfigEvent[T Config] struct {
+	Type     ConfigEventType
+	Revision int64
+	Config   T
+	Err      *Error
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	ParamRefSelf       = "self"
+	ParamRefStandalone = "standalone"
+	ParamRefGroup      = "group"
+)
+
+// paramRefPattern matches references such as ${self:key}, ${standalone:name@version:key}
+// and ${group:name@version:paramset.key}, other ${...} text (e.g. ${env:HOME}) is left as it is.
+// A reference written with $${ is escaped and kept as literal text with a single $
+var paramRefPattern = regexp.MustCompile(`\$(\$?)\{(self|standalone|group):([^}]*)\}`)
+
+// ParamRef identifies a single param of a config version,
+// ParamSet is empty for standalone configs
+type ParamRef struct {
+	ConfigType string
+	Name       string
+	Version    string
+	ParamSet   string
+	Key        string
+}
+
+func (r ParamRef) String() string {
+	if r.ConfigType == ConfTypeGroup {
+		return fmt.Sprintf("%s:%s@%s:%s.%s", ParamRefGroup, r.Name, r.Version, r.ParamSet, r.Key)
+	}
+	return fmt.Sprintf("%s:%s@%s:%s", ParamRefStandalone, r.Name, r.Version, r.Key)
+}
+
+// parseParamRef parses a self, standalone or group reference found in the value of the owner param,
+// self references point to another param of the same param set and a missing version means the latest one
+func parseParamRef(kind, body string, owner ParamRef) (ParamRef, *Error) {
+	if kind == ParamRefSelf {
+		if body == "" {
+			return ParamRef{}, NewError(ErrTypeSchemaInvalid, "self reference must name a param")
+		}
+		ref := owner
+		ref.Key = body
+		return ref, nil
+	}
+	target, key, ok := strings.Cut(body, ":")
+	if !ok || target == "" || key == "" {
+		return ParamRef{}, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("reference ${%s:%s} must have the form <name>@<version>:<key>", kind, body))
+	}
+	name, version, ok := strings.Cut(target, "@")
+	if !ok || version == "" {
+		version = VersionLatest
+	}
+	if kind == ParamRefStandalone {
+		return ParamRef{ConfigType: ConfTypeStandalone, Name: name, Version: version, Key: key}, nil
+	}
+	paramSet, key, ok := strings.Cut(key, ".")
+	if !ok || paramSet == "" || key == "" {
+		return ParamRef{}, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("reference ${%s:%s} must name a param as <paramset>.<key>", kind, body))
+	}
+	return ParamRef{ConfigType: ConfTypeGroup, Name: name, Version: version, ParamSet: paramSet, Key: key}, nil
+}
+
+// ParamLookup returns the raw (not interpolated) value of the referenced param and whether the param is secret
+type ParamLookup func(ref ParamRef) (string, bool, *Error)
+
+// ParamInterpolator resolves the references in param values,
+// resolved params are cached so each param is looked up at most once
+type ParamInterpolator struct {
+	lookup   ParamLookup
+	resolved map[ParamRef]string
+	secrets  map[ParamRef]bool
+	visiting map[ParamRef]bool
+}
+
+func NewParamInterpolator(lookup ParamLookup) *ParamInterpolator {
+	return &ParamInterpolator{
+		lookup:   lookup,
+		resolved: make(map[ParamRef]string),
+		secrets:  make(map[ParamRef]bool),
+		visiting: make(map[ParamRef]bool),
+	}
+}
+
+// Resolve returns the value of the param with all references (transitively) replaced
+func (i *ParamInterpolator) Resolve(ref ParamRef) (string, *Error) {
+	if value, ok := i.resolved[ref]; ok {
+		return value, nil
+	}
+	if i.visiting[ref] {
+		return "", NewError(ErrTypeSchemaInvalid, fmt.Sprintf("reference cycle through ${%s}", ref))
+	}
+	i.visiting[ref] = true
+	defer delete(i.visiting, ref)
+
+	raw, secret, err := i.lookup(ref)
+	if err != nil {
+		return "", err
+	}
+	value, referencesSecret, err := i.interpolate(ref, raw)
+	if err != nil {
+		return "", err
+	}
+	i.resolved[ref] = value
+	i.secrets[ref] = secret || referencesSecret
+	return value, nil
+}
+
+// IsSecret reports whether the resolved value of the param is secret, either because the param is secret
+// or because it (transitively) references a secret param
+func (i *ParamInterpolator) IsSecret(ref ParamRef) bool {
+	return i.secrets[ref]
+}
+
+// Interpolate replaces the references in a value of the owner param
+func (i *ParamInterpolator) Interpolate(owner ParamRef, value string) (string, *Error) {
+	interpolated, _, err := i.interpolate(owner, value)
+	return interpolated, err
+}
+
+// interpolate replaces the references in a value and reports whether any of the referenced values is secret
+func (i *ParamInterpolator) interpolate(owner ParamRef, value string) (string, bool, *Error) {
+	var resolveErr *Error
+	referencesSecret := false
+	interpolated := paramRefPattern.ReplaceAllStringFunc(value, func(match string) string {
+		if resolveErr != nil {
+			return match
+		}
+		groups := paramRefPattern.FindStringSubmatch(match)
+		if groups[1] != "" {
+			return match[1:]
+		}
+		ref, err := parseParamRef(groups[2], groups[3], owner)
+		if err != nil {
+			resolveErr = err
+			return match
+		}
+		resolved, err := i.Resolve(ref)
+		if err != nil {
+			resolveErr = err
+			return match
+		}
+		referencesSecret = referencesSecret || i.secrets[ref]
+		return resolved
+	})
+	if resolveErr != nil {
+		return "", false, resolveErr
+	}
+	return interpolated, referencesSecret, nil
+}
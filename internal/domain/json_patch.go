@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// checkedJSONPatch returns the patch which turns the reference tree into the target tree,
+// it is applied to the reference before it is returned so a patch that doesn't reproduce the target never leaves
+func checkedJSONPatch(reference, target map[string]any) ([]JSONPatchOperation, *Error) {
+	ops := treeJSONPatch("", reference, target, make([]JSONPatchOperation, 0))
+	patched, err := applyJSONPatch(reference, ops)
+	if err != nil {
+		return nil, NewError(ErrTypeInternal, fmt.Sprintf("json patch doesn't apply to the reference: %s", err.Message()))
+	}
+	if !reflect.DeepEqual(patched, target) {
+		return nil, NewError(ErrTypeInternal, "json patch doesn't turn the reference into the compared config")
+	}
+	return ops, nil
+}
+
+// treeJSONPatch appends the operations which turn the reference node into the target node, maps and lists are patched
+// per key and index, lists drop their trailing elements from the end so the indexes of the remaining ones don't move
+func treeJSONPatch(path string, reference, target any, ops []JSONPatchOperation) []JSONPatchOperation {
+	switch referenceNode := reference.(type) {
+	case map[string]any:
+		targetNode, ok := target.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(referenceNode)+len(targetNode))
+		for key := range referenceNode {
+			keys = append(keys, key)
+		}
+		for key := range targetNode {
+			if _, ok := referenceNode[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			childPath := path + "/" + escapeJSONPointer(key)
+			referenceChild, inReference := referenceNode[key]
+			targetChild, inTarget := targetNode[key]
+			switch {
+			case !inTarget:
+				ops = append(ops, JSONPatchOperation{Op: "remove", Path: childPath})
+			case !inReference:
+				ops = append(ops, JSONPatchOperation{Op: "add", Path: childPath, Value: targetChild})
+			default:
+				ops = treeJSONPatch(childPath, referenceChild, targetChild, ops)
+			}
+		}
+		return ops
+	case []any:
+		targetNode, ok := target.([]any)
+		if !ok {
+			break
+		}
+		common := min(len(referenceNode), len(targetNode))
+		for i := 0; i < common; i++ {
+			ops = treeJSONPatch(path+"/"+strconv.Itoa(i), referenceNode[i], targetNode[i], ops)
+		}
+		for i := len(referenceNode) - 1; i >= common; i-- {
+			ops = append(ops, JSONPatchOperation{Op: "remove", Path: path + "/" + strconv.Itoa(i)})
+		}
+		for i := common; i < len(targetNode); i++ {
+			ops = append(ops, JSONPatchOperation{Op: "add", Path: path + "/" + strconv.Itoa(i), Value: targetNode[i]})
+		}
+		return ops
+	}
+	if reflect.DeepEqual(reference, target) {
+		return ops
+	}
+	return append(ops, JSONPatchOperation{Op: "replace", Path: path, Value: target})
+}
+
+// applyJSONPatch applies the add, remove and replace operations of an RFC 6902 patch to a copy of the document
+func applyJSONPatch(document map[string]any, ops []JSONPatchOperation) (map[string]any, *Error) {
+	var patched any = copyJSONNode(document)
+	for _, op := range ops {
+		if !strings.HasPrefix(op.Path, "/") {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("json patch path %q must start with /", op.Path))
+		}
+		tokens := strings.Split(op.Path[1:], "/")
+		for i, token := range tokens {
+			tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		}
+		var err *Error
+		patched, err = applyJSONPatchOperation(patched, tokens, op)
+		if err != nil {
+			return nil, err
+		}
+	}
+	result, ok := patched.(map[string]any)
+	if !ok {
+		return nil, NewError(ErrTypeSchemaInvalid, "json patch must leave an object")
+	}
+	return result, nil
+}
+
+// applyJSONPatchOperation returns the node with the operation applied at the path of the remaining tokens
+func applyJSONPatchOperation(node any, tokens []string, op JSONPatchOperation) (any, *Error) {
+	token, last := tokens[0], len(tokens) == 1
+	switch value := node.(type) {
+	case map[string]any:
+		child, ok := value[token]
+		if !last {
+			if !ok {
+				return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("json patch path %s doesn't exist", op.Path))
+			}
+			patched, err := applyJSONPatchOperation(child, tokens[1:], op)
+			if err != nil {
+				return nil, err
+			}
+			value[token] = patched
+			return value, nil
+		}
+		switch op.Op {
+		case "add":
+			value[token] = copyJSONNode(op.Value)
+		case "replace", "remove":
+			if !ok {
+				return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("json patch path %s doesn't exist", op.Path))
+			}
+			if op.Op == "remove" {
+				delete(value, token)
+			} else {
+				value[token] = copyJSONNode(op.Value)
+			}
+		default:
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unsupported json patch operation: %s", op.Op))
+		}
+		return value, nil
+	case []any:
+		index, err := strconv.Atoi(token)
+		if token == "-" && last && op.Op == "add" {
+			index, err = len(value), nil
+		}
+		if err != nil || index < 0 || index > len(value) || (index == len(value) && (!last || op.Op != "add")) {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("json patch path %s doesn't exist", op.Path))
+		}
+		if !last {
+			patched, err := applyJSONPatchOperation(value[index], tokens[1:], op)
+			if err != nil {
+				return nil, err
+			}
+			value[index] = patched
+			return value, nil
+		}
+		switch op.Op {
+		case "add":
+			return slices.Insert(value, index, copyJSONNode(op.Value)), nil
+		case "remove":
+			return slices.Delete(value, index, index+1), nil
+		case "replace":
+			value[index] = copyJSONNode(op.Value)
+			return value, nil
+		default:
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unsupported json patch operation: %s", op.Op))
+		}
+	default:
+		return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("json patch path %s doesn't exist", op.Path))
+	}
+}
+
+// copyJSONNode deep copies the maps and lists of a node, so patching never changes the documents the values come from
+func copyJSONNode(node any) any {
+	switch value := node.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(value))
+		for key, child := range value {
+			copied[key] = copyJSONNode(child)
+		}
+		return copied
+	case []any:
+		copied := make([]any, len(value))
+		for i, child := range value {
+			copied[i] = copyJSONNode(child)
+		}
+		return copied
+	default:
+		return value
+	}
+}
@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckedJSONPatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		reference map[string]any
+		target    map[string]any
+		ops       int
+	}{
+		{
+			name:      "identical",
+			reference: map[string]any{"a": "1", "b": map[string]any{"c": true}},
+			target:    map[string]any{"a": "1", "b": map[string]any{"c": true}},
+			ops:       0,
+		},
+		{
+			name:      "added, removed and replaced keys",
+			reference: map[string]any{"a": "1", "b": "2"},
+			target:    map[string]any{"a": "3", "c": "4"},
+			ops:       3,
+		},
+		{
+			name:      "nested maps",
+			reference: map[string]any{"db": map[string]any{"host": "localhost", "port": 5432}},
+			target:    map[string]any{"db": map[string]any{"host": "db.internal", "port": 5432, "user": "app"}},
+			ops:       2,
+		},
+		{
+			name:      "grown list",
+			reference: map[string]any{"hosts": []any{"a", "b"}},
+			target:    map[string]any{"hosts": []any{"a", "c", "d", "e"}},
+			ops:       3,
+		},
+		{
+			name:      "shrunk list",
+			reference: map[string]any{"hosts": []any{"a", "b", "c", "d"}},
+			target:    map[string]any{"hosts": []any{"x"}},
+			ops:       4,
+		},
+		{
+			name:      "list of maps",
+			reference: map[string]any{"routes": []any{map[string]any{"path": "/", "port": 80}}},
+			target:    map[string]any{"routes": []any{map[string]any{"path": "/api", "port": 80}, map[string]any{"path": "/", "port": 81}}},
+			ops:       2,
+		},
+		{
+			name:      "changed node type",
+			reference: map[string]any{"a": map[string]any{"b": "1"}, "c": []any{"1"}},
+			target:    map[string]any{"a": "1", "c": map[string]any{"d": "1"}},
+			ops:       2,
+		},
+		{
+			name:      "keys with pointer characters",
+			reference: map[string]any{"a/b": "1", "m~n": map[string]any{"x/y": "2"}},
+			target:    map[string]any{"a/b": "2", "m~n": map[string]any{"x/y": "3", "~": "4"}},
+			ops:       3,
+		},
+		{
+			name:      "null, false and zero values",
+			reference: map[string]any{"a": nil, "b": true, "c": 1},
+			target:    map[string]any{"a": false, "b": false, "c": 0, "d": nil},
+			ops:       4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reference := copyJSONNode(tt.reference).(map[string]any)
+			ops, err := checkedJSONPatch(reference, tt.target)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Message())
+			}
+			if len(ops) != tt.ops {
+				t.Errorf("got %d operations, want %d: %v", len(ops), tt.ops, ops)
+			}
+			patched, err := applyJSONPatch(reference, ops)
+			if err != nil {
+				t.Fatalf("patch doesn't apply: %s", err.Message())
+			}
+			if !reflect.DeepEqual(patched, tt.target) {
+				t.Errorf("patched reference is %v, want %v", patched, tt.target)
+			}
+			if !reflect.DeepEqual(reference, tt.reference) {
+				t.Errorf("reference was changed to %v", reference)
+			}
+		})
+	}
+}
+
+func TestApplyJSONPatchErrors(t *testing.T) {
+	document := map[string]any{"a": map[string]any{"b": "1"}, "list": []any{"x"}}
+	tests := []struct {
+		name string
+		op   JSONPatchOperation
+	}{
+		{name: "relative path", op: JSONPatchOperation{Op: "add", Path: "a", Value: "1"}},
+		{name: "missing parent", op: JSONPatchOperation{Op: "add", Path: "/missing/b", Value: "1"}},
+		{name: "replace missing key", op: JSONPatchOperation{Op: "replace", Path: "/a/c", Value: "1"}},
+		{name: "remove missing key", op: JSONPatchOperation{Op: "remove", Path: "/c"}},
+		{name: "index out of range", op: JSONPatchOperation{Op: "replace", Path: "/list/1", Value: "y"}},
+		{name: "invalid index", op: JSONPatchOperation{Op: "add", Path: "/list/first", Value: "y"}},
+		{name: "path through a scalar", op: JSONPatchOperation{Op: "add", Path: "/a/b/c", Value: "1"}},
+		{name: "unsupported operation", op: JSONPatchOperation{Op: "move", Path: "/a/b"}},
+		{name: "replaced document", op: JSONPatchOperation{Op: "replace", Path: "/", Value: "1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := applyJSONPatch(document, []JSONPatchOperation{tt.op})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if err.ErrType() != ErrTypeSchemaInvalid {
+				t.Errorf("got error type %v, want %v", err.ErrType(), ErrTypeSchemaInvalid)
+			}
+		})
+	}
+}
+
+func TestConfigDiffJSONPatch(t *testing.T) {
+	standalone := func(version string, params map[string]string) *StandaloneConfig {
+		return InitStandaloneConfig("org", "ns", version, 0, *NewParamSet("app", params))
+	}
+	group := func(version string, paramSets ...*NamedParamSet) *ConfigGroup {
+		sets := make([]NamedParamSet, 0, len(paramSets))
+		for _, paramSet := range paramSets {
+			sets = append(sets, *paramSet)
+		}
+		return InitConfigGroup("org", "ns", "group", version, 0, sets)
+	}
+	tests := []struct {
+		name string
+		diff interface {
+			JSONPatch() ([]JSONPatchOperation, *Error)
+		}
+	}{
+		{
+			name: "standalone nested params",
+			diff: StandaloneConfigDiff{
+				Reference: standalone("1.0.0", map[string]string{"db.host": "localhost", "db.port": "5432", "hosts.0": "a"}),
+				Target:    standalone("1.1.0", map[string]string{"db.host": "db.internal", "hosts.0": "a", "hosts.1": "b"}),
+			},
+		},
+		{
+			name: "group with added and removed param sets",
+			diff: ConfigGroupDiff{
+				Reference: group("1.0.0", NewParamSet("db", map[string]string{"host": "localhost"}), NewParamSet("cache", map[string]string{"ttl": "60"})),
+				Target:    group("1.1.0", NewParamSet("db", map[string]string{"host": "db.internal"}), NewParamSet("empty", map[string]string{})),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops, err := tt.diff.JSONPatch()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Message())
+			}
+			var reference, target map[string]any
+			switch diff := tt.diff.(type) {
+			case StandaloneConfigDiff:
+				reference, target = diff.Reference.NamedParamSet().Tree(), diff.Target.NamedParamSet().Tree()
+			case ConfigGroupDiff:
+				reference, target = paramSetTrees(diff.Reference), paramSetTrees(diff.Target)
+			}
+			patched, err := applyJSONPatch(reference, ops)
+			if err != nil {
+				t.Fatalf("patch doesn't apply: %s", err.Message())
+			}
+			if !reflect.DeepEqual(patched, target) {
+				t.Errorf("patched reference is %v, want %v", patched, target)
+			}
+		})
+	}
+}
+
+func TestConfigDiffJSONPatchRedactedReplacements(t *testing.T) {
+	config := InitStandaloneConfig("org", "ns", "1.0.0", 0, *NewParamSet("app", map[string]string{"password": RedactedParamValue}))
+	diff := StandaloneConfigDiff{Reference: config, Target: config, RedactedReplacements: true}
+	if _, err := diff.JSONPatch(); err == nil || err.ErrType() != ErrTypeUnauthorized {
+		t.Errorf("got %v, want an unauthorized error", err)
+	}
+	if _, err := diff.Unified(); err == nil || err.ErrType() != ErrTypeUnauthorized {
+		t.Errorf("got %v, want an unauthorized error", err)
+	}
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+type ComparisonOperator string
+
+const (
+	CompOpEq  ComparisonOperator = "="
+	CompOpNeq ComparisonOperator = "!="
+	CompOpLt  ComparisonOperator = "<"
+	CompOpGt  ComparisonOperator = ">"
+)
+
+func GetComparisonOperatorValues() []ComparisonOperator {
+	return []ComparisonOperator{
+		CompOpEq,
+		CompOpNeq,
+		CompOpLt,
+		CompOpGt,
+	}
+}
+
+// LabelSelector follows the semantics of magnetar node selectors,
+// values are compared as numbers when both sides are numeric and as strings otherwise
+type LabelSelector struct {
+	LabelKey string
+	ShouldBe ComparisonOperator
+	Value    string
+}
+
+func (s LabelSelector) Validate() *Error {
+	if s.LabelKey == "" {
+		return NewError(ErrTypeSchemaInvalid, "label selector key can't be empty")
+	}
+	if !slices.Contains(GetComparisonOperatorValues(), s.ShouldBe) {
+		return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown comparison operator: %s", s.ShouldBe))
+	}
+	return nil
+}
+
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	value, ok := labels[s.LabelKey]
+	if !ok {
+		return false
+	}
+	cmp := compareLabelValues(value, s.Value)
+	switch s.ShouldBe {
+	case CompOpEq:
+		return cmp == 0
+	case CompOpNeq:
+		return cmp != 0
+	case CompOpLt:
+		return cmp < 0
+	case CompOpGt:
+		return cmp > 0
+	default:
+		return false
+	}
+}
+
+func compareLabelValues(a, b string) int {
+	aNum, aErr := strconv.ParseFloat(a, 64)
+	bNum, bErr := strconv.ParseFloat(b, 64)
+	if aErr != nil || bErr != nil {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		default:
+			return 0
+		}
+	}
+	switch {
+	case aNum < bNum:
+		return -1
+	case aNum > bNum:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func ValidateLabels(labels map[string]string) *Error {
+	for key := range labels {
+		if key == "" {
+			return NewError(ErrTypeSchemaInvalid, "label key can't be empty")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type ListSortField string
+
+// ListSortByName orders by name and then by version precedence, without a sort field the results
+// follow the store key order, in which the versions of a name are ordered as text
+const (
+	ListSortByName      ListSortField = "name"
+	ListSortByVersion   ListSortField = "version"
+	ListSortByCreatedAt ListSortField = "createdAt"
+)
+
+func GetListSortFieldValues() []ListSortField {
+	return []ListSortField{
+		ListSortByName,
+		ListSortByVersion,
+		ListSortByCreatedAt,
+	}
+}
+
+type ListOptions struct {
+	PageSize   int
+	PageToken  string
+	NamePrefix string
+	// CreatedAfter and CreatedBefore are unix timestamps (seconds),
+	// the lower bound is inclusive and the upper bound exclusive, zero means unbounded
+	CreatedAfter  int64
+	CreatedBefore int64
+	LatestOnly    bool
+	Selectors     []LabelSelector
+	SortBy        ListSortField
+	SortDesc      bool
+	// Revision is the store revision to list at, zero lists the current state
+	Revision int64
+}
+
+func (o ListOptions) Validate() *Error {
+	if o.PageSize < 0 {
+		return NewError(ErrTypeSchemaInvalid, "page size can't be negative")
+	}
+	if o.SortBy != "" && !slices.Contains(GetListSortFieldValues(), o.SortBy) {
+		return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown sort field: %s", o.SortBy))
+	}
+	for _, selector := range o.Selectors {
+		if err := selector.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// KeyOrdered reports whether the results follow the store key order (name, then version as text),
+// in which case the store can paginate by scanning its key range instead of loading the whole namespace
+func (o ListOptions) KeyOrdered() bool {
+	return !o.LatestOnly && o.SortBy == ""
+}
+
+func (o ListOptions) Matches(config Config) bool {
+	if !strings.HasPrefix(config.Name(), o.NamePrefix) {
+		return false
+	}
+	if o.CreatedAfter != 0 && config.CreatedAtUnixSec() < o.CreatedAfter {
+		return false
+	}
+	if o.CreatedBefore != 0 && config.CreatedAtUnixSec() >= o.CreatedBefore {
+		return false
+	}
+	for _, selector := range o.Selectors {
+		if !selector.Matches(config.Labels()) {
+			return false
+		}
+	}
+	return true
+}
+
+func EncodePageToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// DecodePageToken returns the key of the last item of the previous page,
+// the key must be under the listed prefix
+func DecodePageToken(token, prefix string) (string, *Error) {
+	key, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(key), prefix) {
+		return "", NewError(ErrTypeSchemaInvalid, "invalid page token")
+	}
+	return string(key), nil
+}
+
+// ListConfigs applies the list options to all configs under the listed prefix,
+// key returns the store key of a config which is used for ordering ties and page tokens
+func ListConfigs[T Config](configs []T, opts ListOptions, prefix string, key func(T) string) ([]T, string, *Error) {
+	filtered := make([]T, 0, len(configs))
+	for _, config := range configs {
+		if opts.Matches(config) {
+			filtered = append(filtered, config)
+		}
+	}
+	if opts.LatestOnly {
+		filtered = latestVersions(filtered)
+	}
+	sortConfigs(filtered, opts.SortBy, opts.SortDesc, key)
+
+	start := 0
+	if opts.PageToken != "" {
+		tokenKey, err := DecodePageToken(opts.PageToken, prefix)
+		if err != nil {
+			return nil, "", err
+		}
+		index := slices.IndexFunc(filtered, func(config T) bool {
+			return key(config) == tokenKey
+		})
+		if index < 0 {
+			return nil, "", NewError(ErrTypeSchemaInvalid, "page token is no longer valid")
+		}
+		start = index + 1
+	}
+	filtered = filtered[start:]
+
+	if opts.PageSize == 0 || len(filtered) <= opts.PageSize {
+		return filtered, "", nil
+	}
+	page := filtered[:opts.PageSize]
+	return page, EncodePageToken(key(page[len(page)-1])), nil
+}
+
+func sortConfigs[T Config](configs []T, by ListSortField, desc bool, key func(T) string) {
+	slices.SortStableFunc(configs, func(a, b T) int {
+		cmp := 0
+		switch by {
+		case ListSortByName:
+			cmp = strings.Compare(a.Name(), b.Name())
+			if cmp == 0 {
+				cmp = CompareVersions(a.Version(), b.Version())
+			}
+		case ListSortByVersion:
+			cmp = CompareVersions(a.Version(), b.Version())
+		case ListSortByCreatedAt:
+			cmp = compareInt64(a.CreatedAtUnixSec(), b.CreatedAtUnixSec())
+		}
+		if cmp == 0 {
+			cmp = strings.Compare(key(a), key(b))
+		}
+		if desc {
+			return -cmp
+		}
+		return cmp
+	})
+}
+
+func latestVersions[T Config](configs []T) []T {
+	latest := make(map[string]T)
+	names := make([]string, 0)
+	for _, config := range configs {
+		current, ok := latest[config.Name()]
+		if !ok {
+			names = append(names, config.Name())
+		}
+		if !ok || CompareVersions(config.Version(), current.Version()) > 0 {
+			latest[config.Name()] = config
+		}
+	}
+	result := make([]T, 0, len(names))
+	for _, name := range names {
+		result = append(result, latest[name])
+	}
+	return result
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
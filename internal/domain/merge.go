@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"maps"
+	"slices"
+)
+
+// MergeConflict is a param that both sides changed differently since their common ancestor,
+// Ours and Theirs are the changes of each side relative to the ancestor
+type MergeConflict struct {
+	// ParamSet is the param set of the conflicting param in a config group, it is empty for standalone configs
+	ParamSet string
+	Key      string
+	Ours     Diff
+	Theirs   Diff
+}
+
+// ThreeWayMergeParamSets merges the changes ours and theirs made to their common ancestor, changes of different params
+// are combined and identical changes are applied once. A param that both sides changed differently is a conflict,
+// it keeps its ancestor value in the merged param set. The merged param set has the name of ours
+func ThreeWayMergeParamSets(ancestor, ours, theirs NamedParamSet) (NamedParamSet, []MergeConflict) {
+	merged := NamedParamSet{
+		name:    ours.name,
+		params:  maps.Clone(ancestor.params),
+		types:   maps.Clone(ancestor.types),
+		secrets: maps.Clone(ancestor.secrets),
+	}
+	if merged.params == nil {
+		merged.params = make(map[string]string)
+	}
+	oursDiffs := diffsByKey(ours.Diff(ancestor))
+	theirsDiffs := diffsByKey(theirs.Diff(ancestor))
+
+	keys := make([]string, 0, len(oursDiffs)+len(theirsDiffs))
+	for key := range oursDiffs {
+		keys = append(keys, key)
+	}
+	for key := range theirsDiffs {
+		if _, ok := oursDiffs[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	conflicts := make([]MergeConflict, 0)
+	for _, key := range keys {
+		oursDiff, oursChanged := oursDiffs[key]
+		theirsDiff, theirsChanged := theirsDiffs[key]
+		switch {
+		case !theirsChanged:
+			merged.apply(ours, oursDiff)
+		case !oursChanged:
+			merged.apply(theirs, theirsDiff)
+		case sameChange(ours, oursDiff, theirs, theirsDiff):
+			merged.apply(ours, oursDiff)
+		default:
+			conflicts = append(conflicts, MergeConflict{
+				Key:    key,
+				Ours:   oursDiff,
+				Theirs: theirsDiff,
+			})
+		}
+	}
+	return merged, conflicts
+}
+
+// ThreeWayMergeConfigGroups merges the param sets of config groups like ThreeWayMergeParamSets, a param set missing on a side
+// is merged as an empty one. A param set that either side removed is left out of the merge, unless it has conflicts
+func ThreeWayMergeConfigGroups(ancestor, ours, theirs *ConfigGroup) ([]NamedParamSet, []MergeConflict) {
+	names := make([]string, 0)
+	for _, group := range []*ConfigGroup{ours, theirs, ancestor} {
+		for _, paramSet := range group.paramSets {
+			if !slices.Contains(names, paramSet.name) {
+				names = append(names, paramSet.name)
+			}
+		}
+	}
+
+	paramSets := make([]NamedParamSet, 0, len(names))
+	conflicts := make([]MergeConflict, 0)
+	for _, name := range names {
+		ancestorParamSet, inAncestor := paramSetOrEmpty(ancestor, name)
+		oursParamSet, inOurs := paramSetOrEmpty(ours, name)
+		theirsParamSet, inTheirs := paramSetOrEmpty(theirs, name)
+		oursParamSet.name = name
+
+		merged, paramSetConflicts := ThreeWayMergeParamSets(ancestorParamSet, oursParamSet, theirsParamSet)
+		for _, conflict := range paramSetConflicts {
+			conflict.ParamSet = name
+			conflicts = append(conflicts, conflict)
+		}
+		// a side that kept the presence of the param set leaves the decision to the other side
+		present := inOurs
+		if inOurs == inAncestor {
+			present = inTheirs
+		}
+		if present || len(paramSetConflicts) > 0 {
+			paramSets = append(paramSets, merged)
+		}
+	}
+	return paramSets, conflicts
+}
+
+func (ps *NamedParamSet) apply(side NamedParamSet, diff Diff) {
+	key := diffKey(diff)
+	if diff.Type() == DiffTypeDeletion {
+		delete(ps.params, key)
+		delete(ps.types, key)
+		delete(ps.secrets, key)
+		return
+	}
+	ps.params[key] = side.params[key]
+	if paramType, ok := side.types[key]; ok {
+		if ps.types == nil {
+			ps.types = make(map[string]ParamType)
+		}
+		ps.types[key] = paramType
+	} else {
+		delete(ps.types, key)
+	}
+	if side.IsSecret(key) {
+		if ps.secrets == nil {
+			ps.secrets = make(map[string]bool)
+		}
+		ps.secrets[key] = true
+	} else {
+		delete(ps.secrets, key)
+	}
+}
+
+// sameChange reports whether both sides deleted the param or changed it to the same value
+func sameChange(ours NamedParamSet, oursDiff Diff, theirs NamedParamSet, theirsDiff Diff) bool {
+	oursDeleted := oursDiff.Type() == DiffTypeDeletion
+	theirsDeleted := theirsDiff.Type() == DiffTypeDeletion
+	if oursDeleted || theirsDeleted {
+		return oursDeleted == theirsDeleted
+	}
+	key := diffKey(oursDiff)
+	return paramValuesEqual(ours.ParamType(key), ours.params[key], theirs.ParamType(key), theirs.params[key])
+}
+
+func diffsByKey(diffs []Diff) map[string]Diff {
+	byKey := make(map[string]Diff, len(diffs))
+	for _, diff := range diffs {
+		byKey[diffKey(diff)] = diff
+	}
+	return byKey
+}
+
+func diffKey(diff Diff) string {
+	switch d := diff.(type) {
+	case Addition:
+		return d.Key
+	case Replace:
+		return d.Key
+	case Deletion:
+		return d.Key
+	}
+	return ""
+}
+
+func paramSetOrEmpty(group *ConfigGroup, name string) (NamedParamSet, bool) {
+	paramSet, err := group.ParamSet(name)
+	if err != nil {
+		return NamedParamSet{name: name}, false
+	}
+	return paramSet, true
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+// MaxOverlayDepth limits the length of a chain of overlays,
+// which also stops resolution of configs that (indirectly) reference themselves
+const MaxOverlayDepth = 16
+
+// ConfigRef identifies a single version of a config
+type ConfigRef struct {
+	Org       Org
+	Namespace string
+	Name      string
+	Version   string
+}
+
+func ConfigRefOf(config Config) ConfigRef {
+	return ConfigRef{
+		Org:       config.Org(),
+		Namespace: config.Namespace(),
+		Name:      config.Name(),
+		Version:   config.Version(),
+	}
+}
+
+func (r ConfigRef) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", r.Org, r.Namespace, r.Name, r.Version)
+}
+
+// WithOrigin returns a copy of the param set in which the params without a known origin
+// are attributed to the given layer
+func (ps NamedParamSet) WithOrigin(layer string) NamedParamSet {
+	origins := make(map[string]string, len(ps.params))
+	for key := range ps.params {
+		origin, ok := ps.origins[key]
+		if !ok {
+			origin = layer
+		}
+		origins[key] = origin
+	}
+	withOrigin := ps
+	withOrigin.origins = origins
+	return withOrigin
+}
+
+// Overlay returns the effective param set of the overlay applied on top of ps,
+// removed keys are dropped and overrides replace the base values together with their types and secrecy
+func (ps NamedParamSet) Overlay(overlay NamedParamSet) NamedParamSet {
+	effective := NamedParamSet{
+		name:    overlay.name,
+		params:  make(map[string]string),
+		types:   make(map[string]ParamType),
+		secrets: make(map[string]bool),
+		origins: make(map[string]string),
+	}
+	for key, value := range ps.params {
+		if slices.Contains(overlay.removals, key) {
+			continue
+		}
+		effective.set(ps, key, value)
+	}
+	for key, value := range overlay.params {
+		delete(effective.types, key)
+		delete(effective.secrets, key)
+		effective.set(overlay, key, value)
+	}
+	return effective
+}
+
+func (ps *NamedParamSet) set(src NamedParamSet, key, value string) {
+	ps.params[key] = value
+	if paramType, ok := src.types[key]; ok {
+		ps.types[key] = paramType
+	}
+	if src.IsSecret(key) {
+		ps.secrets[key] = true
+	}
+	if origin, ok := src.origins[key]; ok {
+		ps.origins[key] = origin
+	}
+}
+
+// ApplyOverlay returns the effective config of the overlay c applied on top of its (effective) base
+func (c *StandaloneConfig) ApplyOverlay(base *StandaloneConfig) *StandaloneConfig {
+	baseParams := base.paramSet.WithOrigin(ConfigRefOf(base).String())
+	overlayParams := c.paramSet.WithOrigin(ConfigRefOf(c).String())
+	return &StandaloneConfig{
+		ConfigBase: c.ConfigBase,
+		paramSet:   baseParams.Overlay(overlayParams),
+	}
+}
+
+// ApplyOverlay returns the effective config of the overlay c applied on top of its (effective) base,
+// param sets are matched by name and param sets missing from the base are added as they are
+func (c *ConfigGroup) ApplyOverlay(base *ConfigGroup) *ConfigGroup {
+	baseLayer := ConfigRefOf(base).String()
+	overlayLayer := ConfigRefOf(c).String()
+	paramSets := make([]NamedParamSet, 0, len(base.paramSets))
+	for _, baseParamSet := range base.paramSets {
+		baseParams := baseParamSet.WithOrigin(baseLayer)
+		overlayParamSet, err := c.ParamSet(baseParamSet.name)
+		if err != nil {
+			paramSets = append(paramSets, baseParams)
+			continue
+		}
+		paramSets = append(paramSets, baseParams.Overlay(overlayParamSet.WithOrigin(overlayLayer)))
+	}
+	for _, overlayParamSet := range c.paramSets {
+		if _, err := base.ParamSet(overlayParamSet.name); err != nil {
+			paramSets = append(paramSets, overlayParamSet.WithOrigin(overlayLayer))
+		}
+	}
+	return &ConfigGroup{
+		ConfigBase: c.ConfigBase,
+		name:       c.name,
+		paramSets:  paramSets,
+	}
+}
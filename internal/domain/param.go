@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+type ParamType string
+
+const (
+	ParamTypeString   ParamType = "string"
+	ParamTypeInt      ParamType = "int"
+	ParamTypeFloat    ParamType = "float"
+	ParamTypeBool     ParamType = "bool"
+	ParamTypeDuration ParamType = "duration"
+	ParamTypeJSON     ParamType = "json"
+	ParamTypeList     ParamType = "list"
+)
+
+func GetParamTypeValues() []ParamType {
+	return []ParamType{
+		ParamTypeString,
+		ParamTypeInt,
+		ParamTypeFloat,
+		ParamTypeBool,
+		ParamTypeDuration,
+		ParamTypeJSON,
+		ParamTypeList,
+	}
+}
+
+// IsValid reports whether the type is known, an empty type is treated as a string
+func (pt ParamType) IsValid() bool {
+	return pt == "" || slices.Contains(GetParamTypeValues(), pt)
+}
+
+// ParseParamValue converts the raw value to a go value of the declared type,
+// lists and json values are decoded as json documents (lists must be json arrays)
+func ParseParamValue(paramType ParamType, value string) (any, error) {
+	switch paramType {
+	case "", ParamTypeString:
+		return value, nil
+	case ParamTypeInt:
+		return strconv.ParseInt(value, 10, 64)
+	case ParamTypeFloat:
+		return strconv.ParseFloat(value, 64)
+	case ParamTypeBool:
+		return strconv.ParseBool(value)
+	case ParamTypeDuration:
+		return time.ParseDuration(value)
+	case ParamTypeJSON, ParamTypeList:
+		var parsed any
+		if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+			return nil, err
+		}
+		if _, ok := parsed.([]any); paramType == ParamTypeList && !ok {
+			return nil, fmt.Errorf("list value must be a json array")
+		}
+		return parsed, nil
+	default:
+		return nil, fmt.Errorf("unknown param type: %s", paramType)
+	}
+}
+
+// NormalizeParamValue returns the canonical representation of the value,
+// so values such as "1.0" and "1" of a float param compare as equal
+func NormalizeParamValue(paramType ParamType, value string) (string, error) {
+	parsed, err := ParseParamValue(paramType, value)
+	if err != nil {
+		return "", err
+	}
+	switch v := parsed.(type) {
+	case string:
+		return v, nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case time.Duration:
+		return v.String(), nil
+	default:
+		// encoding/json sorts object keys, so equal documents have the same encoding
+		normalized, err := json.Marshal(v)
+		return string(normalized), err
+	}
+}
+
+// YAMLValue returns the value that is sent for schema validation,
+// durations are kept in their textual form since yaml has no duration type
+func YAMLValue(paramType ParamType, value string) any {
+	parsed, err := ParseParamValue(paramType, value)
+	if err != nil {
+		return value
+	}
+	if _, ok := parsed.(time.Duration); ok {
+		return value
+	}
+	return parsed
+}
+
+func paramValuesEqual(aType ParamType, a string, bType ParamType, b string) bool {
+	if normalizeParamType(aType) != normalizeParamType(bType) {
+		return false
+	}
+	aNormalized, aErr := NormalizeParamValue(aType, a)
+	bNormalized, bErr := NormalizeParamValue(bType, b)
+	if aErr != nil || bErr != nil {
+		return a == b
+	}
+	return aNormalized == bNormalized
+}
+
+func normalizeParamType(paramType ParamType) ParamType {
+	if paramType == "" {
+		return ParamTypeString
+	}
+	return paramType
+}
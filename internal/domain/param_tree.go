@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParamPathSeparator separates the segments of a flattened param path,
+// list elements are addressed by their index (e.g. servers.0.host)
+const ParamPathSeparator = "."
+
+// ParseParamTree decodes a yaml (or json) document holding a nested param tree
+func ParseParamTree(document string) (map[string]any, *Error) {
+	tree := make(map[string]any)
+	if err := yaml.Unmarshal([]byte(document), &tree); err != nil {
+		return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("invalid param tree: %s", err.Error()))
+	}
+	return tree, nil
+}
+
+// NewParamSetFromTree flattens a nested tree of maps, lists and scalars into a param set keyed by full paths,
+// scalar types are kept as param types so the tree can be rebuilt with the same values
+func NewParamSetFromTree(name string, tree map[string]any) (*NamedParamSet, *Error) {
+	params := make(map[string]string)
+	types := make(map[string]ParamType)
+	if err := flattenParamTree("", tree, params, types); err != nil {
+		return nil, err
+	}
+	return NewTypedParamSet(name, params, types), nil
+}
+
+// MergeParamSets adds all params of src to dst, a path present in both is an error
+func MergeParamSets(dst, src *NamedParamSet) *Error {
+	if dst.params == nil {
+		dst.params = make(map[string]string)
+	}
+	if dst.types == nil {
+		dst.types = make(map[string]ParamType)
+	}
+	if dst.secrets == nil {
+		dst.secrets = make(map[string]bool)
+	}
+	for key, value := range src.params {
+		if _, ok := dst.params[key]; ok {
+			return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param %s is defined more than once", key))
+		}
+		dst.params[key] = value
+		if paramType, ok := src.types[key]; ok {
+			dst.types[key] = paramType
+		}
+		if src.IsSecret(key) {
+			dst.secrets[key] = true
+		}
+	}
+	return nil
+}
+
+// Tree returns the params as a nested tree, paths are split on the separator
+// and nested maps whose keys are consecutive indexes starting from zero become lists
+func (ps NamedParamSet) Tree() map[string]any {
+	keys := make([]string, 0, len(ps.params))
+	for key := range ps.params {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	tree := make(paramTreeNode)
+	for _, key := range keys {
+		segments := strings.Split(key, ParamPathSeparator)
+		node := tree
+		for _, segment := range segments[:len(segments)-1] {
+			child, ok := node[segment].(paramTreeNode)
+			if !ok {
+				child = make(paramTreeNode)
+				node[segment] = child
+			}
+			node = child
+		}
+		node[segments[len(segments)-1]] = YAMLValue(ps.ParamType(key), ps.params[key])
+	}
+	result := make(map[string]any)
+	for key, child := range tree {
+		if node, ok := child.(paramTreeNode); ok {
+			child = node.build()
+		}
+		result[key] = child
+	}
+	return result
+}
+
+// paramTreeNode is an inner node built from param paths,
+// unlike json values stored in a single param it may be turned into a list
+type paramTreeNode map[string]any
+
+func (n paramTreeNode) build() any {
+	children := make(map[string]any)
+	list := make([]any, len(n))
+	isList := len(n) > 0
+	for key, child := range n {
+		if node, ok := child.(paramTreeNode); ok {
+			child = node.build()
+		}
+		children[key] = child
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index >= len(n) || strconv.Itoa(index) != key {
+			isList = false
+			continue
+		}
+		list[index] = child
+	}
+	if isList {
+		return list
+	}
+	return children
+}
+
+func validateParamPaths(params map[string]string) *Error {
+	for key := range params {
+		segments := strings.Split(key, ParamPathSeparator)
+		if len(segments) == 1 {
+			continue
+		}
+		if slices.Contains(segments, "") {
+			return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param path %s has an empty segment", key))
+		}
+		for i := 1; i < len(segments); i++ {
+			parent := strings.Join(segments[:i], ParamPathSeparator)
+			if _, ok := params[parent]; ok {
+				return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param %s can't hold both a value and the nested param %s", parent, key))
+			}
+		}
+	}
+	return nil
+}
+
+func flattenParamTree(path string, node any, params map[string]string, types map[string]ParamType) *Error {
+	switch value := node.(type) {
+	case map[string]any:
+		if len(value) == 0 && path != "" {
+			params[path], types[path] = "{}", ParamTypeJSON
+		}
+		for key, child := range value {
+			if key == "" || strings.Contains(key, ParamPathSeparator) {
+				return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param tree key %q must be non-empty and can't contain %q", key, ParamPathSeparator))
+			}
+			if err := flattenParamTree(joinParamPath(path, key), child, params, types); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if len(value) == 0 {
+			params[path], types[path] = "[]", ParamTypeJSON
+		}
+		for i, child := range value {
+			if err := flattenParamTree(joinParamPath(path, strconv.Itoa(i)), child, params, types); err != nil {
+				return err
+			}
+		}
+	case string:
+		params[path] = value
+	case int:
+		params[path], types[path] = strconv.Itoa(value), ParamTypeInt
+	case float64:
+		params[path], types[path] = strconv.FormatFloat(value, 'g', -1, 64), ParamTypeFloat
+	case bool:
+		params[path], types[path] = strconv.FormatBool(value), ParamTypeBool
+	case time.Time:
+		params[path] = value.Format(time.RFC3339Nano)
+	case nil:
+		params[path], types[path] = "null", ParamTypeJSON
+	default:
+		return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param %s has an unsupported value %v", path, value))
+	}
+	return nil
+}
+
+func joinParamPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + ParamPathSeparator + key
+}
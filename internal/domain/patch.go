@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+type PatchOpType string
+
+const (
+	PatchOpAddition         = PatchOpType(DiffTypeAddition)
+	PatchOpReplace          = PatchOpType(DiffTypeReplace)
+	PatchOpDeletion         = PatchOpType(DiffTypeDeletion)
+	PatchOpParamSetAddition = PatchOpType("param_set_addition")
+	PatchOpParamSetDeletion = PatchOpType("param_set_deletion")
+)
+
+func GetPatchOpTypeValues() []PatchOpType {
+	return []PatchOpType{
+		PatchOpAddition,
+		PatchOpReplace,
+		PatchOpDeletion,
+		PatchOpParamSetAddition,
+		PatchOpParamSetDeletion,
+	}
+}
+
+func (t PatchOpType) IsValid() bool {
+	return slices.Contains(GetPatchOpTypeValues(), t)
+}
+
+// PatchOp changes a single param of a config, or adds or removes a whole param set of a config group
+type PatchOp struct {
+	Type PatchOpType
+	// ParamSet is the param set of the changed param in a config group, or the added or removed param set
+	ParamSet string
+	Key      string
+	Value    string
+	// ParamType is the type of an added or replaced param, a replaced param keeps its type if it is empty
+	ParamType ParamType
+	// Secret marks an added or replaced param as secret, a replaced secret param stays secret
+	Secret bool
+}
+
+// Patch returns a new version of the (effective) config with the ops applied in order,
+// the new version isn't an overlay and keeps the labels and annotations of the config
+func (c *StandaloneConfig) Patch(version string, ops []PatchOp) (*StandaloneConfig, *Error) {
+	paramSet := c.paramSet.detached()
+	for _, op := range ops {
+		if op.Type == PatchOpParamSetAddition || op.Type == PatchOpParamSetDeletion {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("%s is only supported by config groups", op.Type))
+		}
+		if err := paramSet.patch(op); err != nil {
+			return nil, err
+		}
+	}
+	patched := NewStandaloneConfig(c.Org(), c.Namespace(), version, paramSet)
+	patched.SetLabels(c.Labels())
+	patched.SetAnnotations(c.Annotations())
+	return patched, nil
+}
+
+// Patch returns a new version of the (effective) config group with the ops applied in order,
+// the new version isn't an overlay and keeps the labels and annotations of the config group
+func (c *ConfigGroup) Patch(version string, ops []PatchOp) (*ConfigGroup, *Error) {
+	paramSets := make([]NamedParamSet, 0, len(c.paramSets))
+	for _, paramSet := range c.paramSets {
+		paramSets = append(paramSets, paramSet.detached())
+	}
+	for _, op := range ops {
+		index := slices.IndexFunc(paramSets, func(paramSet NamedParamSet) bool {
+			return paramSet.name == op.ParamSet
+		})
+		switch {
+		case op.Type == PatchOpParamSetAddition:
+			if index >= 0 {
+				return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param set %s already exists", op.ParamSet))
+			}
+			paramSets = append(paramSets, NewParamSet(op.ParamSet, make(map[string]string)).detached())
+		case index < 0:
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param set %s not found", op.ParamSet))
+		case op.Type == PatchOpParamSetDeletion:
+			paramSets = slices.Delete(paramSets, index, index+1)
+		default:
+			if err := paramSets[index].patch(op); err != nil {
+				return nil, NewError(err.ErrType(), fmt.Sprintf("param set %s: %s", op.ParamSet, err.Message()))
+			}
+		}
+	}
+	patched := NewConfigGroup(c.Org(), c.Namespace(), c.Name(), version, paramSets)
+	patched.SetLabels(c.Labels())
+	patched.SetAnnotations(c.Annotations())
+	return patched, nil
+}
+
+// PatchOverlay returns a new version of the overlay c with the ops applied to its effective config on top of base,
+// the new version stays an overlay of the same base and only the params the ops touch change in its layer
+func (c *StandaloneConfig) PatchOverlay(base *StandaloneConfig, version string, ops []PatchOp) (*StandaloneConfig, *Error) {
+	effective, err := c.ApplyOverlay(base).Patch(version, ops)
+	if err != nil {
+		return nil, err
+	}
+	patched := NewStandaloneConfig(c.Org(), c.Namespace(), version, c.paramSet.patchedLayer(base.paramSet, effective.paramSet, ops))
+	patched.SetLabels(c.Labels())
+	patched.SetAnnotations(c.Annotations())
+	patched.SetBase(c.Base())
+	return patched, nil
+}
+
+// PatchOverlay returns a new version of the overlay c with the ops applied to its effective config on top of base,
+// the new version stays an overlay of the same base and only the params the ops touch change in its layer.
+// An overlay can't drop a param set of its base, so deleting one fails
+func (c *ConfigGroup) PatchOverlay(base *ConfigGroup, version string, ops []PatchOp) (*ConfigGroup, *Error) {
+	for _, op := range ops {
+		if _, err := base.ParamSet(op.ParamSet); op.Type == PatchOpParamSetDeletion && err == nil {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param set %s comes from the base %s and can't be deleted by an overlay", op.ParamSet, ConfigRefOf(base)))
+		}
+	}
+	effective, err := c.ApplyOverlay(base).Patch(version, ops)
+	if err != nil {
+		return nil, err
+	}
+	paramSets := make([]NamedParamSet, 0, len(effective.paramSets))
+	for _, effectiveParamSet := range effective.paramSets {
+		name := effectiveParamSet.name
+		baseParamSet, baseErr := base.ParamSet(name)
+		layerParamSet, layerErr := c.ParamSet(name)
+		paramOps := make([]PatchOp, 0)
+		for _, op := range ops {
+			if op.ParamSet == name && op.Type != PatchOpParamSetAddition && op.Type != PatchOpParamSetDeletion {
+				paramOps = append(paramOps, op)
+			}
+		}
+		switch {
+		case baseErr != nil:
+			// param sets missing from the base are kept whole in the layer
+			paramSets = append(paramSets, effectiveParamSet)
+		case layerErr == nil || len(paramOps) > 0:
+			if layerErr != nil {
+				layerParamSet = *NewParamSet(name, make(map[string]string))
+			}
+			paramSets = append(paramSets, layerParamSet.patchedLayer(baseParamSet, effectiveParamSet, paramOps))
+		}
+	}
+	patched := NewConfigGroup(c.Org(), c.Namespace(), c.Name(), version, paramSets)
+	patched.SetLabels(c.Labels())
+	patched.SetAnnotations(c.Annotations())
+	patched.SetBase(c.Base())
+	return patched, nil
+}
+
+// patchedLayer returns a copy of the overlay layer ps in which the params touched by the ops are taken from the patched
+// effective param set, touched params that are gone from it are dropped from the layer and removed from the base
+func (ps NamedParamSet) patchedLayer(base, effective NamedParamSet, ops []PatchOp) NamedParamSet {
+	layer := ps.detached()
+	removals := slices.Clone(ps.Removals())
+	for _, op := range ops {
+		key := op.Key
+		delete(layer.params, key)
+		delete(layer.types, key)
+		delete(layer.secrets, key)
+		removals = slices.DeleteFunc(removals, func(removal string) bool {
+			return removal == key
+		})
+		if value, ok := effective.params[key]; ok {
+			layer.set(effective, key, value)
+		} else if _, inBase := base.params[key]; inBase {
+			removals = append(removals, key)
+		}
+	}
+	layer.SetRemovals(removals)
+	return layer
+}
+
+// patch applies a param op, additions fail for existing params and replacements and deletions for missing ones
+func (ps *NamedParamSet) patch(op PatchOp) *Error {
+	_, exists := ps.params[op.Key]
+	switch op.Type {
+	case PatchOpAddition:
+		if exists {
+			return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param %s already exists", op.Key))
+		}
+	case PatchOpReplace, PatchOpDeletion:
+		if !exists {
+			return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("param %s not found", op.Key))
+		}
+	default:
+		return NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown patch op type: %s", op.Type))
+	}
+
+	if op.Type == PatchOpDeletion {
+		delete(ps.params, op.Key)
+		delete(ps.types, op.Key)
+		delete(ps.secrets, op.Key)
+		return nil
+	}
+	if op.ParamType != "" {
+		ps.types[op.Key] = op.ParamType
+	}
+	if op.Secret {
+		ps.secrets[op.Key] = true
+	}
+	ps.params[op.Key] = op.Value
+	return nil
+}
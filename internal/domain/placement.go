@@ -76,8 +76,15 @@ func (p *PlacementTask) Status() PlacementTaskStatus {
 	return p.status
 }
 
+// Active reports whether the config is (or is about to be) applied on the node of the task
+func (p *PlacementTask) Active() bool {
+	return p.status == PlacementTaskStatusAccepted || p.status == PlacementTaskStatusPlaced
+}
+
 type PlacementStore interface {
 	Place(ctx context.Context, config Config, req *PlacementTask) *Error
 	ListByConfig(ctx context.Context, org Org, namespace, name, version, configType string) ([]PlacementTask, *Error)
 	UpdateStatus(ctx context.Context, org Org, namespace, name, version, configType, taskId string, status PlacementTaskStatus) *Error
+	// DeleteByConfig removes all placement tasks of a config version
+	DeleteByConfig(ctx context.Context, org Org, namespace, name, version, configType string) *Error
 }
@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+// Provenance records the config version a config was promoted from
+type Provenance struct {
+	Source ConfigRef
+	// CreatedAt is the creation time (unix sec) of the source version
+	CreatedAt int64
+}
+
+func (p *Provenance) CreatedAtUTC() time.Time {
+	return time.Unix(p.CreatedAt, 0).UTC()
+}
+
+func ProvenanceOf(config Config) *Provenance {
+	return &Provenance{
+		Source:    ConfigRefOf(config),
+		CreatedAt: config.CreatedAtUnixSec(),
+	}
+}
+
+// PromoteTo returns a copy of the (effective) config in the target namespace,
+// the copy isn't an overlay and records the config as its provenance
+func (c *StandaloneConfig) PromoteTo(org Org, namespace, version string) *StandaloneConfig {
+	promoted := NewStandaloneConfig(org, namespace, version, c.paramSet.detached())
+	promoted.SetLabels(c.Labels())
+	promoted.SetAnnotations(c.Annotations())
+	promoted.SetPromotedFrom(ProvenanceOf(c))
+	return promoted
+}
+
+// PromoteTo returns a copy of the (effective) config in the target namespace,
+// the copy isn't an overlay and records the config as its provenance
+func (c *ConfigGroup) PromoteTo(org Org, namespace, version string) *ConfigGroup {
+	paramSets := make([]NamedParamSet, 0, len(c.paramSets))
+	for _, paramSet := range c.paramSets {
+		paramSets = append(paramSets, paramSet.detached())
+	}
+	promoted := NewConfigGroup(org, namespace, c.name, version, paramSets)
+	promoted.SetLabels(c.Labels())
+	promoted.SetAnnotations(c.Annotations())
+	promoted.SetPromotedFrom(ProvenanceOf(c))
+	return promoted
+}
+
+// detached returns a copy of the param set without the overlay removals and origins
+func (ps NamedParamSet) detached() NamedParamSet {
+	detached := NamedParamSet{
+		name:    ps.name,
+		params:  make(map[string]string, len(ps.params)),
+		types:   make(map[string]ParamType, len(ps.types)),
+		secrets: make(map[string]bool, len(ps.secrets)),
+	}
+	for key, value := range ps.params {
+		detached.params[key] = value
+	}
+	for key, paramType := range ps.types {
+		detached.types[key] = paramType
+	}
+	for key, secret := range ps.secrets {
+		detached.secrets[key] = secret
+	}
+	return detached
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetentionRule decides which versions of a config are kept, a version is kept if it is one of the last
+// KeepLast versions of its config or if it is younger than MaxAge, zero values don't keep anything on their own
+type RetentionRule struct {
+	KeepLast int
+	MaxAge   time.Duration
+}
+
+// Empty reports whether the rule doesn't expire any version
+func (r RetentionRule) Empty() bool {
+	return r.KeepLast == 0 && r.MaxAge == 0
+}
+
+func (r RetentionRule) String() string {
+	parts := make([]string, 0, 2)
+	if r.KeepLast > 0 {
+		parts = append(parts, fmt.Sprintf("last:%d", r.KeepLast))
+	}
+	if r.MaxAge > 0 {
+		parts = append(parts, fmt.Sprintf("days:%d", int(r.MaxAge/(24*time.Hour))))
+	}
+	return strings.Join(parts, ";")
+}
+
+// ExpiredVersions returns the versions the rule doesn't keep, the order of versions is decided by their semantic version
+func ExpiredVersions[T Config](configs []T, rule RetentionRule, now time.Time) []T {
+	if rule.Empty() {
+		return nil
+	}
+	byName := make(map[string][]T)
+	names := make([]string, 0)
+	for _, config := range configs {
+		if _, ok := byName[config.Name()]; !ok {
+			names = append(names, config.Name())
+		}
+		byName[config.Name()] = append(byName[config.Name()], config)
+	}
+	expired := make([]T, 0)
+	for _, name := range names {
+		versions := byName[name]
+		slices.SortStableFunc(versions, func(a, b T) int {
+			return CompareVersions(b.Version(), a.Version())
+		})
+		for i, config := range versions {
+			if rule.KeepLast > 0 && i < rule.KeepLast {
+				continue
+			}
+			if rule.MaxAge > 0 && now.Sub(config.CreatedAtUTC()) < rule.MaxAge {
+				continue
+			}
+			expired = append(expired, config)
+		}
+	}
+	return expired
+}
+
+// RetentionPolicy holds the retention rules per namespace (org/namespace), namespaces without a rule keep every version
+type RetentionPolicy map[string]RetentionRule
+
+// ParseRetentionPolicy parses a comma separated list of org/namespace=rule entries,
+// a rule holds last:<versions> and/or days:<days> separated by a semicolon, e.g. acme/prod=last:10;days:30
+func ParseRetentionPolicy(policy string) (RetentionPolicy, *Error) {
+	parsed := make(RetentionPolicy)
+	for _, entry := range strings.Split(policy, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		namespace, rule, ok := strings.Cut(entry, "=")
+		if !ok || !strings.Contains(namespace, "/") {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("invalid retention policy entry %q, expected <org>/<namespace>=<rule>", entry))
+		}
+		parsedRule, err := parseRetentionRule(rule)
+		if err != nil {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("invalid retention policy entry %q: %s", entry, err.Message()))
+		}
+		parsed[namespace] = parsedRule
+	}
+	return parsed, nil
+}
+
+func parseRetentionRule(rule string) (RetentionRule, *Error) {
+	parsed := RetentionRule{}
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), ":")
+		count, err := strconv.Atoi(value)
+		if !ok || err != nil || count <= 0 {
+			return RetentionRule{}, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("expected last:<versions> or days:<days>, got %q", part))
+		}
+		switch key {
+		case "last":
+			parsed.KeepLast = count
+		case "days":
+			parsed.MaxAge = time.Duration(count) * 24 * time.Hour
+		default:
+			return RetentionRule{}, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("unknown retention rule %q", key))
+		}
+	}
+	return parsed, nil
+}
+
+func (p RetentionPolicy) Rule(org Org, namespace string) (RetentionRule, bool) {
+	rule, ok := p[fmt.Sprintf("%s/%s", org, namespace)]
+	return rule, ok
+}
+
+// Namespaces returns the org and namespace of every rule, sorted
+func (p RetentionPolicy) Namespaces() []ConfigRef {
+	keys := make([]string, 0, len(p))
+	for key := range p {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	namespaces := make([]ConfigRef, 0, len(keys))
+	for _, key := range keys {
+		org, namespace, _ := strings.Cut(key, "/")
+		namespaces = append(namespaces, ConfigRef{Org: Org(org), Namespace: namespace})
+	}
+	return namespaces
+}
+
+// RetentionCandidate is a version the retention rule of its namespace doesn't keep,
+// Reason explains why it is kept anyway, it is empty for versions that are collected
+type RetentionCandidate struct {
+	ConfigType string
+	Config     ConfigRef
+	CreatedAt  int64
+	Reason     string
+}
+
+type RetentionReport struct {
+	Rule    RetentionRule
+	Expired []RetentionCandidate
+	Kept    []RetentionCandidate
+}
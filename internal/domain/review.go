@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Draft is the review state of a config version that is stored but can't be placed until it is approved
+type Draft struct {
+	Author            string
+	RequiredApprovals int
+	Approvers         []string
+}
+
+func NewDraft(author string, requiredApprovals int) *Draft {
+	return &Draft{
+		Author:            author,
+		RequiredApprovals: max(requiredApprovals, 1),
+		Approvers:         make([]string, 0),
+	}
+}
+
+// Approve records the approval of a subject, the author can't approve their own draft and each subject counts once
+func (d *Draft) Approve(subject string) *Error {
+	if subject == d.Author {
+		return NewError(ErrTypeUnauthorized, "the author of a draft can't approve it")
+	}
+	if slices.Contains(d.Approvers, subject) {
+		return NewError(ErrTypeFailedPrecondition, fmt.Sprintf("%s has already approved the draft", subject))
+	}
+	d.Approvers = append(d.Approvers, subject)
+	return nil
+}
+
+func (d *Draft) Approved() bool {
+	return len(d.Approvers) >= d.RequiredApprovals
+}
+
+type ReviewDecision int8
+
+const (
+	// ReviewKeep stores the updated draft
+	ReviewKeep ReviewDecision = iota
+	// ReviewApprove stores the version as a regular (placeable) config
+	ReviewApprove
+	// ReviewDiscard deletes the version, it is kept as a tombstone until the grace period is over
+	ReviewDiscard
+)
+
+// DraftReview updates the draft of a stored version and decides what happens to the version,
+// stores apply it atomically so concurrent approvals are not lost
+type DraftReview func(draft *Draft) (ReviewDecision, *Error)
+
+type draftConfig interface {
+	Config
+	Draft() *Draft
+	SetDraft(draft *Draft)
+}
+
+// ApplyDraftReview runs the review on the draft of the config and reports whether the version should be deleted,
+// a discarded config is left as it was
+func ApplyDraftReview(config draftConfig, review DraftReview) (bool, *Error) {
+	if config.Draft() == nil {
+		return false, NewError(ErrTypeFailedPrecondition, fmt.Sprintf("version %s of %s is not a draft", config.Version(), config.Name()))
+	}
+	draft := *config.Draft()
+	draft.Approvers = slices.Clone(draft.Approvers)
+	decision, err := review(&draft)
+	if err != nil {
+		return false, err
+	}
+	switch decision {
+	case ReviewApprove:
+		config.SetDraft(nil)
+	case ReviewDiscard:
+		return true, nil
+	default:
+		config.SetDraft(&draft)
+	}
+	return false, nil
+}
+
+// ReviewPolicy holds the number of distinct approvals new versions need per namespace (org/namespace),
+// namespaces without an entry don't require review
+type ReviewPolicy map[string]int
+
+// ParseReviewPolicy parses a comma separated list of org/namespace=approvals entries
+func ParseReviewPolicy(policy string) (ReviewPolicy, *Error) {
+	parsed := make(ReviewPolicy)
+	for _, entry := range strings.Split(policy, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		namespace, count, ok := strings.Cut(entry, "=")
+		approvals, err := strconv.Atoi(count)
+		if !ok || !strings.Contains(namespace, "/") || err != nil || approvals < 0 {
+			return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("invalid review policy entry %q, expected <org>/<namespace>=<approvals>", entry))
+		}
+		parsed[namespace] = approvals
+	}
+	return parsed, nil
+}
+
+func (p ReviewPolicy) RequiredApprovals(org Org, namespace string) int {
+	return p[fmt.Sprintf("%s/%s", org, namespace)]
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// PointInTime selects the state of the store to read, either by store revision or by a unix timestamp (seconds),
+// the zero value reads the current state
+type PointInTime struct {
+	Revision int64
+	Time     int64
+}
+
+func (p PointInTime) IsZero() bool {
+	return p.Revision == 0 && p.Time == 0
+}
+
+func (p PointInTime) Validate() *Error {
+	if p.Revision < 0 || p.Time < 0 {
+		return NewError(ErrTypeSchemaInvalid, "revision and time can't be negative")
+	}
+	if p.Revision != 0 && p.Time != 0 {
+		return NewError(ErrTypeSchemaInvalid, "only one of revision and time can be set")
+	}
+	return nil
+}
+
+func (p PointInTime) String() string {
+	if p.Time != 0 {
+		return time.Unix(p.Time, 0).UTC().String()
+	}
+	return fmt.Sprintf("revision %d", p.Revision)
+}
@@ -0,0 +1,71 @@
+package domain
+
+// RedactedParamValue replaces the values of secret params in responses
+// sent to callers that aren't allowed to reveal them
+const RedactedParamValue = "******"
+
+// SecretCipher encrypts secret param values before they are stored
+// and decrypts them when they have to be revealed or placed
+type SecretCipher interface {
+	Encrypt(plaintext string) (string, *Error)
+	Decrypt(ciphertext string) (string, *Error)
+	// Digest returns a keyed digest of the plaintext, equal values have equal digests
+	// but the value can't be guessed from its digest without the key
+	Digest(plaintext string) (string, *Error)
+}
+
+func RedactSecret(string) (string, *Error) {
+	return RedactedParamValue, nil
+}
+
+// RedactDiffs replaces the values of diffs whose keys are secret
+func RedactDiffs(diffs []Diff, isSecret func(key string) bool) []Diff {
+	redacted := make([]Diff, 0, len(diffs))
+	for _, diff := range diffs {
+		switch d := diff.(type) {
+		case Addition:
+			if isSecret(d.Key) {
+				d.Value = RedactedParamValue
+			}
+			diff = d
+		case Replace:
+			if isSecret(d.Key) {
+				d.New = RedactedParamValue
+				d.Old = RedactedParamValue
+			}
+			diff = d
+		case Deletion:
+			if isSecret(d.Key) {
+				d.Value = RedactedParamValue
+			}
+			diff = d
+		}
+		redacted = append(redacted, diff)
+	}
+	return redacted
+}
+
+// SecretReplaced reports whether one of the diffs replaces the value of a secret param,
+// a replacement isn't visible anymore once both values are redacted
+func SecretReplaced(diffs []Diff, isSecret func(key string) bool) bool {
+	for _, diff := range diffs {
+		if d, ok := diff.(Replace); ok && isSecret(d.Key) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactMergeConflicts replaces the values of conflicts whose params are secret
+func RedactMergeConflicts(conflicts []MergeConflict, isSecret func(paramSet, key string) bool) []MergeConflict {
+	redacted := make([]MergeConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		secret := func(key string) bool {
+			return isSecret(conflict.ParamSet, key)
+		}
+		conflict.Ours = RedactDiffs([]Diff{conflict.Ours}, secret)[0]
+		conflict.Theirs = RedactDiffs([]Diff{conflict.Theirs}, secret)[0]
+		redacted = append(redacted, conflict)
+	}
+	return redacted
+}
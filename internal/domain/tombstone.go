@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// Tombstone records the deletion of a config version, deleted versions are kept until the grace period
+// for restoring them is over
+type Tombstone struct {
+	DeletedBy string
+	DeletedAt int64
+}
+
+func NewTombstone(deletedBy string, deletedAt time.Time) *Tombstone {
+	return &Tombstone{
+		DeletedBy: deletedBy,
+		DeletedAt: deletedAt.Unix(),
+	}
+}
+
+func (t *Tombstone) DeletedAtUTC() time.Time {
+	return time.Unix(t.DeletedAt, 0).UTC()
+}
+
+// Expired reports whether the grace period of the tombstone is over
+func (t *Tombstone) Expired(gracePeriod time.Duration, now time.Time) bool {
+	return now.Sub(t.DeletedAtUTC()) >= gracePeriod
+}
@@ -0,0 +1,330 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const VersionLatest = "latest"
+
+type SemVersion struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string
+}
+
+func ParseSemVersion(version string) (SemVersion, bool) {
+	v, parts, ok := parseVersionParts(version)
+	if !ok || parts != 3 {
+		return SemVersion{}, false
+	}
+	return v, true
+}
+
+func (v SemVersion) Compare(cmp SemVersion) int {
+	if v.Major != cmp.Major {
+		return compareUint(v.Major, cmp.Major)
+	}
+	if v.Minor != cmp.Minor {
+		return compareUint(v.Minor, cmp.Minor)
+	}
+	if v.Patch != cmp.Patch {
+		return compareUint(v.Patch, cmp.Patch)
+	}
+	// a version without prerelease identifiers has a higher precedence
+	if len(v.Prerelease) == 0 || len(cmp.Prerelease) == 0 {
+		return compareUint(uint64(len(cmp.Prerelease)), uint64(len(v.Prerelease)))
+	}
+	for i := 0; i < len(v.Prerelease) && i < len(cmp.Prerelease); i++ {
+		if c := comparePrereleaseIdentifier(v.Prerelease[i], cmp.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.Prerelease)), uint64(len(cmp.Prerelease)))
+}
+
+// CompareVersions orders semantic versions by precedence,
+// versions that are not valid semantic versions are ordered before them lexically
+func CompareVersions(a, b string) int {
+	aSemVer, aOk := ParseSemVersion(a)
+	bSemVer, bOk := ParseSemVersion(b)
+	switch {
+	case aOk && bOk:
+		if cmp := aSemVer.Compare(bSemVer); cmp != 0 {
+			return cmp
+		}
+		return strings.Compare(a, b)
+	case aOk:
+		return 1
+	case bOk:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// IsVersionQuery reports whether the version should be resolved
+// against the stored versions instead of being used as an exact key
+func IsVersionQuery(version string) bool {
+	version = strings.TrimSpace(version)
+	if version == VersionLatest {
+		return true
+	}
+	if strings.ContainsAny(version, "^~<>=*| ") {
+		return true
+	}
+	for _, part := range strings.Split(strings.TrimPrefix(version, "v"), ".") {
+		if part == "x" || part == "X" {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveVersion returns the highest of the versions that satisfies the query,
+// versions which are not valid semantic versions are ignored
+func ResolveVersion(query string, versions []string) (string, *Error) {
+	constraint, err := parseVersionConstraint(query)
+	if err != nil {
+		return "", err
+	}
+	resolved := ""
+	var resolvedSemVer SemVersion
+	for _, version := range versions {
+		semVer, ok := ParseSemVersion(version)
+		if !ok || !constraint.matches(semVer) {
+			continue
+		}
+		if resolved == "" || semVer.Compare(resolvedSemVer) > 0 {
+			resolved = version
+			resolvedSemVer = semVer
+		}
+	}
+	if resolved == "" {
+		return "", NewError(ErrTypeNotFound, fmt.Sprintf("no version matches %s", query))
+	}
+	return resolved, nil
+}
+
+type versionComparator struct {
+	op      string
+	version SemVersion
+}
+
+func (c versionComparator) matches(v SemVersion) bool {
+	cmp := v.Compare(c.version)
+	switch c.op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	default:
+		return cmp == 0
+	}
+}
+
+// versionConstraint is a union of comparator sets,
+// a version matches a set if it satisfies all of its comparators
+type versionConstraint struct {
+	sets [][]versionComparator
+}
+
+func (c versionConstraint) matches(v SemVersion) bool {
+	for _, set := range c.sets {
+		matches := true
+		prereleaseAllowed := len(v.Prerelease) == 0
+		for _, comparator := range set {
+			if !comparator.matches(v) {
+				matches = false
+				break
+			}
+			if len(comparator.version.Prerelease) > 0 &&
+				comparator.version.Major == v.Major && comparator.version.Minor == v.Minor && comparator.version.Patch == v.Patch {
+				prereleaseAllowed = true
+			}
+		}
+		if matches && prereleaseAllowed {
+			return true
+		}
+	}
+	return false
+}
+
+func parseVersionConstraint(query string) (versionConstraint, *Error) {
+	query = strings.TrimSpace(query)
+	if query == VersionLatest {
+		return versionConstraint{sets: [][]versionComparator{{}}}, nil
+	}
+	constraint := versionConstraint{}
+	for _, rawSet := range strings.Split(query, "||") {
+		set := make([]versionComparator, 0)
+		for _, rawComparator := range splitComparators(rawSet) {
+			comparators, err := parseComparator(rawComparator)
+			if err != nil {
+				return versionConstraint{}, err
+			}
+			set = append(set, comparators...)
+		}
+		if len(set) == 0 {
+			return versionConstraint{}, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("invalid version constraint: %s", query))
+		}
+		constraint.sets = append(constraint.sets, set)
+	}
+	return constraint, nil
+}
+
+// splitComparators splits a comparator set by whitespace
+// while keeping the operators attached to their versions (e.g. ">= 2.0.0")
+func splitComparators(set string) []string {
+	comparators := make([]string, 0)
+	operator := ""
+	for _, field := range strings.Fields(set) {
+		if strings.Trim(field, "<>=^~") == "" {
+			operator += field
+			continue
+		}
+		comparators = append(comparators, operator+field)
+		operator = ""
+	}
+	return comparators
+}
+
+func parseComparator(comparator string) ([]versionComparator, *Error) {
+	op := ""
+	for _, prefix := range []string{">=", "<=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(comparator, prefix) {
+			op = prefix
+			break
+		}
+	}
+	version, parts, ok := parseVersionParts(strings.TrimPrefix(comparator, op))
+	if !ok {
+		return nil, NewError(ErrTypeSchemaInvalid, fmt.Sprintf("invalid version constraint: %s", comparator))
+	}
+	if parts == 0 {
+		// "*" or "x" matches every version
+		return []versionComparator{{op: ">=", version: SemVersion{}}}, nil
+	}
+	lower := versionComparator{op: ">=", version: version}
+	switch op {
+	case "^":
+		switch {
+		case version.Major > 0 || parts == 1:
+			return []versionComparator{lower, {op: "<", version: SemVersion{Major: version.Major + 1}}}, nil
+		case version.Minor > 0 || parts == 2:
+			return []versionComparator{lower, {op: "<", version: SemVersion{Minor: version.Minor + 1}}}, nil
+		default:
+			return []versionComparator{lower, {op: "<", version: SemVersion{Patch: version.Patch + 1}}}, nil
+		}
+	case "~":
+		if parts == 1 {
+			return []versionComparator{lower, {op: "<", version: SemVersion{Major: version.Major + 1}}}, nil
+		}
+		return []versionComparator{lower, {op: "<", version: SemVersion{Major: version.Major, Minor: version.Minor + 1}}}, nil
+	case ">":
+		if parts < 3 {
+			return []versionComparator{{op: ">=", version: nextPartialVersion(version, parts)}}, nil
+		}
+	case "<=":
+		if parts < 3 {
+			return []versionComparator{{op: "<", version: nextPartialVersion(version, parts)}}, nil
+		}
+	case "", "=":
+		if parts < 3 {
+			return []versionComparator{lower, {op: "<", version: nextPartialVersion(version, parts)}}, nil
+		}
+		op = "="
+	}
+	return []versionComparator{{op: op, version: version}}, nil
+}
+
+func nextPartialVersion(version SemVersion, parts int) SemVersion {
+	if parts == 1 {
+		return SemVersion{Major: version.Major + 1}
+	}
+	return SemVersion{Major: version.Major, Minor: version.Minor + 1}
+}
+
+// parseVersionParts parses a full or partial version (e.g. "1", "1.2", "1.x", "v1.2.3-rc.1")
+// and returns the number of specified numeric parts
+func parseVersionParts(version string) (SemVersion, int, bool) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if version == "" {
+		return SemVersion{}, 0, false
+	}
+	if i := strings.Index(version, "+"); i >= 0 {
+		version = version[:i]
+	}
+	var prerelease []string
+	if i := strings.Index(version, "-"); i >= 0 {
+		prerelease = strings.Split(version[i+1:], ".")
+		version = version[:i]
+		for _, identifier := range prerelease {
+			if identifier == "" {
+				return SemVersion{}, 0, false
+			}
+		}
+	}
+	rawParts := strings.Split(version, ".")
+	if len(rawParts) > 3 {
+		return SemVersion{}, 0, false
+	}
+	numbers := make([]uint64, 0, 3)
+	for _, rawPart := range rawParts {
+		if rawPart == "x" || rawPart == "X" || rawPart == "*" {
+			break
+		}
+		number, err := strconv.ParseUint(rawPart, 10, 64)
+		if err != nil {
+			return SemVersion{}, 0, false
+		}
+		numbers = append(numbers, number)
+	}
+	if len(numbers) < 3 && len(prerelease) > 0 {
+		return SemVersion{}, 0, false
+	}
+	semVer := SemVersion{Prerelease: prerelease}
+	for i, number := range numbers {
+		switch i {
+		case 0:
+			semVer.Major = number
+		case 1:
+			semVer.Minor = number
+		case 2:
+			semVer.Patch = number
+		}
+	}
+	return semVer, len(numbers), true
+}
+
+func comparePrereleaseIdentifier(a, b string) int {
+	aNum, aErr := strconv.ParseUint(a, 10, 64)
+	bNum, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareUint(aNum, bNum)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "testing"
+
+func TestResolveVersion(t *testing.T) {
+	versions := []string{
+		"0.1.0", "0.1.5", "0.2.0", "1.0.0", "1.2.0", "1.2.7", "1.3.0-rc.1", "1.3.0", "2.0.0", "2.1.0", "3.0.0-beta.1", "dev",
+	}
+	tests := []struct {
+		query   string
+		want    string
+		errType ErrorType
+	}{
+		{query: VersionLatest, want: "2.1.0"},
+		{query: "1.2.7", want: "1.2.7"},
+		{query: "^1.2.0", want: "1.3.0"},
+		{query: "^0.1.0", want: "0.1.5"},
+		{query: "^0.2", want: "0.2.0"},
+		{query: "~1.2.0", want: "1.2.7"},
+		{query: "~1", want: "1.3.0"},
+		{query: "1.x", want: "1.3.0"},
+		{query: "1.2", want: "1.2.7"},
+		{query: "1.2.x", want: "1.2.7"},
+		{query: "0.x", want: "0.2.0"},
+		{query: "*", want: "2.1.0"},
+		{query: ">= 1.0.0 < 1.3.0", want: "1.2.7"},
+		{query: "<=1.2", want: "1.2.7"},
+		{query: ">1", want: "2.1.0"},
+		{query: "^0.1.0 || ^2.0.0", want: "2.1.0"},
+		{query: "<1.0.0 || ~1.2.0", want: "1.2.7"},
+		{query: "^1.3.0-rc.1", want: "1.3.0"},
+		{query: ">=1.3.0-rc.1 <1.3.0", want: "1.3.0-rc.1"},
+		{query: ">=3.0.0-beta.1", want: "3.0.0-beta.1"},
+		{query: "^4.0.0", errType: ErrTypeNotFound},
+		{query: "^2.1.1 || <0.1.0", errType: ErrTypeNotFound},
+		{query: "^a", errType: ErrTypeSchemaInvalid},
+		{query: "1.0.0 ||", errType: ErrTypeSchemaInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			got, err := ResolveVersion(tt.query, versions)
+			if tt.want == "" {
+				if err == nil {
+					t.Fatalf("resolved to %s, want an error", got)
+				}
+				if err.ErrType() != tt.errType {
+					t.Errorf("got error type %v, want %v", err.ErrType(), tt.errType)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Message())
+			}
+			if got != tt.want {
+				t.Errorf("resolved to %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package servers
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/c12s/kuiper/internal/domain"
 	"github.com/c12s/kuiper/internal/services"
@@ -18,105 +21,98 @@ type KuiperGrpcServer struct {
 	api.UnimplementedKuiperServer
 	standalone *services.StandaloneConfigService
 	groups     *services.ConfigGroupService
+	bundles    *services.BundleService
+	batches    *services.ConfigBatchService
+	promotions *services.PromotionService
+	audit      *services.AuditService
+	retention  *services.RetentionService
 }
 
-func NewKuiperServer(standalone *services.StandaloneConfigService, groups *services.ConfigGroupService) api.KuiperServer {
+func NewKuiperServer(standalone *services.StandaloneConfigService, groups *services.ConfigGroupService, bundles *services.BundleService, batches *services.ConfigBatchService, promotions *services.PromotionService, audit *services.AuditService, retention *services.RetentionService) api.KuiperServer {
 	return &KuiperGrpcServer{
 		standalone: standalone,
 		groups:     groups,
+		bundles:    bundles,
+		batches:    batches,
+		promotions: promotions,
+		audit:      audit,
+		retention:  retention,
 	}
 }
 
 func (s *KuiperGrpcServer) PutStandaloneConfig(ctx context.Context, req *api.NewStandaloneConfig) (*api.StandaloneConfig, error) {
-	paramSet := mapProtoParamSet(req.Name, req.ParamSet)
-	config := domain.NewStandaloneConfig(domain.Org(req.Organization), req.Namespace, req.Version, *paramSet)
-	var schema *quasarapi.ConfigSchemaDetails
-	if req.Schema != nil {
-		schema = &quasarapi.ConfigSchemaDetails{
-			Organization: req.Organization,
-			SchemaName:   req.Schema.Name,
-			Version:      req.Schema.Version,
-		}
+	config, schema, err := mapProtoStandaloneConfig(req)
+	if err := mapError(err); err != nil {
+		return nil, err
 	}
-
-	config, err := s.standalone.Put(ctx, config, schema)
+	duplicates, err := domain.ParseDuplicateContentPolicy(req.DuplicateContent)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
-	resp := &api.StandaloneConfig{
-		Organization: string(config.Org()),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSet:     mapParamSet(config.ParamSet()),
+
+	config, err = s.standalone.Put(ctx, config, schema, duplicates)
+	if err := mapError(err); err != nil {
+		return nil, err
 	}
+	resp := mapStandaloneConfig(config, api.ParamFormat_Flat)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) GetStandaloneConfig(ctx context.Context, req *api.ConfigId) (*api.StandaloneConfig, error) {
-	config, err := s.standalone.Get(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version)
+	config, err := s.standalone.Get(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version, domain.PointInTime{Revision: req.AtRevision, Time: req.AtTime})
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
-	resp := &api.StandaloneConfig{
-		Organization: string(config.Org()),
-		Namespace:    config.Namespace(),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSet:     mapParamSet(config.ParamSet()),
-	}
+	resp := mapStandaloneConfig(config, req.ParamFormat)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) ListStandaloneConfig(ctx context.Context, req *api.ListStandaloneConfigReq) (*api.ListStandaloneConfigResp, error) {
-	configs, err := s.standalone.List(ctx, domain.Org(req.Organization), req.Namespace)
+	opts := mapListOptions(req.PageSize, req.PageToken, req.Filter, req.Sort)
+	configs, nextPageToken, err := s.standalone.List(ctx, domain.Org(req.Organization), req.Namespace, opts, domain.PointInTime{Revision: req.AtRevision, Time: req.AtTime})
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
 	resp := &api.ListStandaloneConfigResp{
 		Configurations: make([]*api.StandaloneConfig, 0),
+		NextPageToken:  nextPageToken,
 	}
 	for _, config := range configs {
-		configProto := &api.StandaloneConfig{
-			Organization: string(config.Org()),
-			Namespace:    config.Namespace(),
-			Name:         config.Name(),
-			Version:      config.Version(),
-			CreatedAt:    config.CreatedAtUTC().String(),
-			ParamSet:     mapParamSet(config.ParamSet()),
-		}
+		configProto := mapStandaloneConfig(config, req.ParamFormat)
 		resp.Configurations = append(resp.Configurations, configProto)
 	}
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) DeleteStandaloneConfig(ctx context.Context, req *api.ConfigId) (*api.StandaloneConfig, error) {
-	config, err := s.standalone.Delete(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version)
+	config, err := s.standalone.Delete(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version, req.Force)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
-	resp := &api.StandaloneConfig{
-		Organization: string(config.Org()),
-		Namespace:    config.Namespace(),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSet:     mapParamSet(config.ParamSet()),
-	}
+	resp := mapStandaloneConfig(config, api.ParamFormat_Flat)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) DiffStandaloneConfig(ctx context.Context, req *api.DiffReq) (*api.DiffStandaloneConfigResp, error) {
-	diffs, err := s.standalone.Diff(ctx, domain.Org(req.Reference.Organization), req.Reference.Namespace, req.Reference.Name, req.Reference.Version, domain.Org(req.Diff.Organization), req.Diff.Namespace, req.Diff.Name, req.Diff.Version)
+	format, err := domain.ParseDiffFormat(req.Format)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	diff, err := s.standalone.Diff(ctx, domain.Org(req.Reference.Organization), req.Reference.Namespace, req.Reference.Name, req.Reference.Version, domain.Org(req.Diff.Organization), req.Diff.Namespace, req.Diff.Name, req.Diff.Version)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	jsonPatch, unifiedDiff, err := renderDiff(diff, format)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
 	resp := &api.DiffStandaloneConfigResp{
-		Diffs: make([]*api.Diff, 0),
+		Diffs:       make([]*api.Diff, 0),
+		JsonPatch:   jsonPatch,
+		UnifiedDiff: unifiedDiff,
 	}
-	for _, diff := range diffs {
-		resp.Diffs = append(resp.Diffs, &api.Diff{Type: string(diff.Type()), Diff: diff.Diff()})
+	if format == domain.DiffFormatList {
+		resp.Diffs = mapDiffs(diff.Diffs)
 	}
 	return resp, nil
 }
@@ -144,104 +140,79 @@ func (s *KuiperGrpcServer) ListPlacementTaskByStandaloneConfig(ctx context.Conte
 }
 
 func (s *KuiperGrpcServer) PutConfigGroup(ctx context.Context, req *api.NewConfigGroup) (*api.ConfigGroup, error) {
-	paramSets := mapProtoParamSets(req.ParamSets)
-	config := domain.NewConfigGroup(domain.Org(req.Organization), req.Namespace, req.Name, req.Version, paramSets)
-	var schema *quasarapi.ConfigSchemaDetails
-	if req.Schema != nil {
-		schema = &quasarapi.ConfigSchemaDetails{
-			Organization: req.Organization,
-			Namespace:    req.Namespace,
-			SchemaName:   req.Schema.Name,
-			Version:      req.Schema.Version,
-		}
+	config, schema, err := mapProtoConfigGroup(req)
+	if err := mapError(err); err != nil {
+		return nil, err
 	}
-
-	config, err := s.groups.Put(ctx, config, schema)
+	duplicates, err := domain.ParseDuplicateContentPolicy(req.DuplicateContent)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
 
-	resp := &api.ConfigGroup{
-		Organization: string(config.Org()),
-		Namespace:    config.Namespace(),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSets:    mapParamSets(config.ParamSets()),
+	config, err = s.groups.Put(ctx, config, schema, duplicates)
+	if err := mapError(err); err != nil {
+		return nil, err
 	}
+
+	resp := mapConfigGroup(config, api.ParamFormat_Flat)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) GetConfigGroup(ctx context.Context, req *api.ConfigId) (*api.ConfigGroup, error) {
-	config, err := s.groups.Get(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version)
+	config, err := s.groups.Get(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version, domain.PointInTime{Revision: req.AtRevision, Time: req.AtTime})
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
-	resp := &api.ConfigGroup{
-		Organization: string(config.Org()),
-		Namespace:    config.Namespace(),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSets:    mapParamSets(config.ParamSets()),
-	}
+	resp := mapConfigGroup(config, req.ParamFormat)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) ListConfigGroup(ctx context.Context, req *api.ListConfigGroupReq) (*api.ListConfigGroupResp, error) {
-	configs, err := s.groups.List(ctx, domain.Org(req.Organization), req.Namespace)
+	opts := mapListOptions(req.PageSize, req.PageToken, req.Filter, req.Sort)
+	configs, nextPageToken, err := s.groups.List(ctx, domain.Org(req.Organization), req.Namespace, opts, domain.PointInTime{Revision: req.AtRevision, Time: req.AtTime})
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
 	resp := &api.ListConfigGroupResp{
-		Groups: make([]*api.ConfigGroup, 0),
+		Groups:        make([]*api.ConfigGroup, 0),
+		NextPageToken: nextPageToken,
 	}
 	for _, config := range configs {
-		configProto := &api.ConfigGroup{
-			Organization: string(config.Org()),
-			Namespace:    config.Namespace(),
-			Name:         config.Name(),
-			Version:      config.Version(),
-			CreatedAt:    config.CreatedAtUTC().String(),
-			ParamSets:    mapParamSets(config.ParamSets()),
-		}
+		configProto := mapConfigGroup(config, req.ParamFormat)
 		resp.Groups = append(resp.Groups, configProto)
 	}
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) DeleteConfigGroup(ctx context.Context, req *api.ConfigId) (*api.ConfigGroup, error) {
-	config, err := s.groups.Delete(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version)
+	config, err := s.groups.Delete(ctx, domain.Org(req.Organization), req.Namespace, req.Name, req.Version, req.Force)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
-	resp := &api.ConfigGroup{
-		Organization: string(config.Org()),
-		Namespace:    config.Namespace(),
-		Name:         config.Name(),
-		Version:      config.Version(),
-		CreatedAt:    config.CreatedAtUTC().String(),
-		ParamSets:    mapParamSets(config.ParamSets()),
-	}
+	resp := mapConfigGroup(config, api.ParamFormat_Flat)
 	return resp, nil
 }
 
 func (s *KuiperGrpcServer) DiffConfigGroup(ctx context.Context, req *api.DiffReq) (*api.DiffConfigGroupResp, error) {
-	diffsByConfig, err := s.groups.Diff(ctx, domain.Org(req.Reference.Organization), req.Reference.Namespace, req.Reference.Name, req.Reference.Version, domain.Org(req.Diff.Organization), req.Diff.Namespace, req.Diff.Name, req.Diff.Version)
+	format, err := domain.ParseDiffFormat(req.Format)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	diff, err := s.groups.Diff(ctx, domain.Org(req.Reference.Organization), req.Reference.Namespace, req.Reference.Name, req.Reference.Version, domain.Org(req.Diff.Organization), req.Diff.Namespace, req.Diff.Name, req.Diff.Version)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	jsonPatch, unifiedDiff, err := renderDiff(diff, format)
 	if err := mapError(err); err != nil {
 		return nil, err
 	}
 	resp := &api.DiffConfigGroupResp{
-		Diffs: make(map[string]*api.Diffs),
+		Diffs:       make(map[string]*api.Diffs),
+		JsonPatch:   jsonPatch,
+		UnifiedDiff: unifiedDiff,
 	}
-	for config, diffs := range diffsByConfig {
-		diffsProto := &api.Diffs{
-			Diffs: make([]*api.Diff, 0),
-		}
-		for _, diff := range diffs {
-			diffsProto.Diffs = append(diffsProto.Diffs, &api.Diff{Type: string(diff.Type()), Diff: diff.Diff()})
-		}
-		resp.Diffs[config] = diffsProto
+	if format == domain.DiffFormatList {
+		resp.Diffs = mapDiffsByParamSet(diff.Diffs)
 	}
 	return resp, nil
 }
@@ -268,6 +239,524 @@ func (s *KuiperGrpcServer) ListPlacementTaskByConfigGroup(ctx context.Context, r
 	return resp, nil
 }
 
+func (s *KuiperGrpcServer) WatchStandaloneConfigs(req *api.WatchReq, stream api.Kuiper_WatchStandaloneConfigsServer) error {
+	events, err := s.standalone.Watch(stream.Context(), domain.Org(req.Organization), req.Namespace, req.Name, req.FromRevision)
+	if err := mapError(err); err != nil {
+		return err
+	}
+	for event := range events {
+		if err := mapError(event.Err); err != nil {
+			return err
+		}
+		eventProto := &api.StandaloneConfigEvent{
+			Type:     string(event.Type),
+			Revision: event.Revision,
+			Config:   mapStandaloneConfig(event.Config, api.ParamFormat_Flat),
+		}
+		if err := stream.Send(eventProto); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *KuiperGrpcServer) WatchConfigGroups(req *api.WatchReq, stream api.Kuiper_WatchConfigGroupsServer) error {
+	events, err := s.groups.Watch(stream.Context(), domain.Org(req.Organization), req.Namespace, req.Name, req.FromRevision)
+	if err := mapError(err); err != nil {
+		return err
+	}
+	for event := range events {
+		if err := mapError(event.Err); err != nil {
+			return err
+		}
+		eventProto := &api.ConfigGroupEvent{
+			Type:     string(event.Type),
+			Revision: event.Revision,
+			Config:   mapConfigGroup(event.Config, api.ParamFormat_Flat),
+		}
+		if err := stream.Send(eventProto); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *KuiperGrpcServer) ExportNamespace(ctx context.Context, req *api.ExportNamespaceReq) (*api.ExportNamespaceResp, error) {
+	format := domain.BundleFormat(req.Format)
+	if format == "" {
+		format = domain.BundleFormatYAML
+	}
+	bundle, err := s.bundles.Export(ctx, domain.Org(req.Organization), req.Namespace, format)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return &api.ExportNamespaceResp{
+		Bundle: bundle,
+		Format: string(format),
+	}, nil
+}
+
+func (s *KuiperGrpcServer) ImportNamespace(ctx context.Context, req *api.ImportNamespaceReq) (*api.ImportNamespaceResp, error) {
+	result, err := s.bundles.Import(ctx, domain.Org(req.Organization), req.Namespace, req.Bundle, domain.BundleFormat(req.Format), domain.ImportConflictMode(req.ConflictMode))
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return &api.ImportNamespaceResp{
+		ImportedStandaloneConfigs: mapConfigRefs(result.ImportedStandaloneConfigs),
+		ImportedConfigGroups:      mapConfigRefs(result.ImportedConfigGroups),
+		SkippedStandaloneConfigs:  mapConfigRefs(result.SkippedStandaloneConfigs),
+		SkippedConfigGroups:       mapConfigRefs(result.SkippedConfigGroups),
+	}, nil
+}
+
+func (s *KuiperGrpcServer) PutBatch(ctx context.Context, req *api.PutBatchReq) (*api.PutBatchResp, error) {
+	standaloneConfigs := make([]services.BatchStandaloneConfig, 0, len(req.StandaloneConfigs))
+	for _, configProto := range req.StandaloneConfigs {
+		config, schema, err := mapProtoStandaloneConfig(configProto)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		duplicates, err := domain.ParseDuplicateContentPolicy(configProto.DuplicateContent)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		standaloneConfigs = append(standaloneConfigs, services.BatchStandaloneConfig{Config: config, Schema: schema, Duplicates: duplicates})
+	}
+	configGroups := make([]services.BatchConfigGroup, 0, len(req.ConfigGroups))
+	for _, configProto := range req.ConfigGroups {
+		config, schema, err := mapProtoConfigGroup(configProto)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		duplicates, err := domain.ParseDuplicateContentPolicy(configProto.DuplicateContent)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		configGroups = append(configGroups, services.BatchConfigGroup{Config: config, Schema: schema, Duplicates: duplicates})
+	}
+
+	created, createdGroups, itemErrs, err := s.batches.PutBatch(ctx, standaloneConfigs, configGroups)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.PutBatchResp{
+		StandaloneConfigs: make([]*api.StandaloneConfig, 0, len(created)),
+		ConfigGroups:      make([]*api.ConfigGroup, 0, len(createdGroups)),
+		Errors:            make([]*api.BatchItemError, 0, len(itemErrs)),
+	}
+	for _, config := range created {
+		resp.StandaloneConfigs = append(resp.StandaloneConfigs, mapStandaloneConfig(config, api.ParamFormat_Flat))
+	}
+	for _, config := range createdGroups {
+		resp.ConfigGroups = append(resp.ConfigGroups, mapConfigGroup(config, api.ParamFormat_Flat))
+	}
+	for _, itemErr := range itemErrs {
+		resp.Errors = append(resp.Errors, &api.BatchItemError{
+			ConfigType: itemErr.ConfigType,
+			Index:      int32(itemErr.Index),
+			Name:       itemErr.Name,
+			Version:    itemErr.Version,
+			Code:       status.Code(mapError(itemErr.Err)).String(),
+			Message:    itemErr.Err.Message(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) PromoteConfig(ctx context.Context, req *api.PromoteConfigReq) (*api.PromoteConfigResp, error) {
+	source := mapProtoConfigRef(req.Source)
+	if source == nil {
+		return nil, status.Error(codes.InvalidArgument, "source config must be set")
+	}
+	duplicates, err := domain.ParseDuplicateContentPolicy(req.DuplicateContent)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	var schema *quasarapi.ConfigSchemaDetails
+	if req.Schema != nil {
+		schema = &quasarapi.ConfigSchemaDetails{
+			Organization: req.TargetOrganization,
+			Namespace:    req.TargetNamespace,
+			SchemaName:   req.Schema.Name,
+			Version:      req.Schema.Version,
+		}
+	}
+
+	switch req.ConfigType {
+	case domain.ConfTypeStandalone:
+		config, err := s.promotions.PromoteStandalone(ctx, *source, domain.Org(req.TargetOrganization), req.TargetNamespace, req.TargetVersion, schema, duplicates)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		return &api.PromoteConfigResp{StandaloneConfig: mapStandaloneConfig(config, api.ParamFormat_Flat)}, nil
+	case domain.ConfTypeGroup:
+		config, err := s.promotions.PromoteGroup(ctx, *source, domain.Org(req.TargetOrganization), req.TargetNamespace, req.TargetVersion, schema, duplicates)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		return &api.PromoteConfigResp{ConfigGroup: mapConfigGroup(config, api.ParamFormat_Flat)}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown config type: %s", req.ConfigType))
+	}
+}
+
+func (s *KuiperGrpcServer) ApproveConfig(ctx context.Context, req *api.ReviewConfigReq) (*api.ReviewConfigResp, error) {
+	if req.Config == nil {
+		return nil, status.Error(codes.InvalidArgument, "config must be set")
+	}
+	id := req.Config
+	switch req.ConfigType {
+	case domain.ConfTypeStandalone:
+		config, err := s.standalone.Approve(ctx, domain.Org(id.Organization), id.Namespace, id.Name, id.Version)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		return &api.ReviewConfigResp{StandaloneConfig: mapStandaloneConfig(config, id.ParamFormat)}, nil
+	case domain.ConfTypeGroup:
+		config, err := s.groups.Approve(ctx, domain.Org(id.Organization), id.Namespace, id.Name, id.Version)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		return &api.ReviewConfigResp{ConfigGroup: mapConfigGroup(config, id.ParamFormat)}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown config type: %s", req.ConfigType))
+	}
+}
+
+func (s *KuiperGrpcServer) RejectConfig(ctx context.Context, req *api.ReviewConfigReq) (*api.ReviewConfigResp, error) {
+	if req.Config == nil {
+		return nil, status.Error(codes.InvalidArgument, "config must be set")
+	}
+	id := req.Config
+	switch req.ConfigType {
+	case domain.ConfTypeStandalone:
+		config, err := s.standalone.Reject(ctx, domain.Org(id.Organization), id.Namespace, id.Name, id.Version)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		return &api.ReviewConfigResp{StandaloneConfig: mapStandaloneConfig(config, id.ParamFormat)}, nil
+	case domain.ConfTypeGroup:
+		config, err := s.groups.Reject(ctx, domain.Org(id.Organization), id.Namespace, id.Name, id.Version)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		return &api.ReviewConfigResp{ConfigGroup: mapConfigGroup(config, id.ParamFormat)}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown config type: %s", req.ConfigType))
+	}
+}
+
+func (s *KuiperGrpcServer) ListAuditEvents(ctx context.Context, req *api.ListAuditEventsReq) (*api.ListAuditEventsResp, error) {
+	filter := domain.AuditFilter{
+		Org:       domain.Org(req.Organization),
+		Namespace: req.Namespace,
+		Actor:     req.Actor,
+		Action:    domain.AuditAction(req.Action),
+		From:      req.From,
+		To:        req.To,
+		PageSize:  int(req.PageSize),
+		PageToken: req.PageToken,
+	}
+	events, nextPageToken, err := s.audit.List(ctx, filter)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.ListAuditEventsResp{
+		Events:        make([]*api.AuditEvent, 0, len(events)),
+		NextPageToken: nextPageToken,
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &api.AuditEvent{
+			Id:         event.Id,
+			Actor:      event.Actor,
+			Action:     string(event.Action),
+			ConfigType: event.ConfigType,
+			Config:     mapConfigRef(&event.Config),
+			Outcome:    string(event.Outcome),
+			ErrorType:  event.ErrType,
+			Message:    event.Message,
+			Detail:     event.Detail,
+			Timestamp:  event.Timestamp.String(),
+		})
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) GetRetentionReport(ctx context.Context, req *api.RetentionReportReq) (*api.RetentionReportResp, error) {
+	report, err := s.retention.Report(ctx, domain.Org(req.Organization), req.Namespace)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return &api.RetentionReportResp{
+		Rule:    report.Rule.String(),
+		Expired: mapRetentionCandidates(report.Expired),
+		Kept:    mapRetentionCandidates(report.Kept),
+	}, nil
+}
+
+func (s *KuiperGrpcServer) ListDeletedConfigs(ctx context.Context, req *api.ListDeletedConfigsReq) (*api.ListDeletedConfigsResp, error) {
+	standaloneConfigs, err := s.standalone.ListDeleted(ctx, domain.Org(req.Organization), req.Namespace)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	configGroups, err := s.groups.ListDeleted(ctx, domain.Org(req.Organization), req.Namespace)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	resp := &api.ListDeletedConfigsResp{
+		StandaloneConfigs: make([]*api.StandaloneConfig, 0, len(standaloneConfigs)),
+		ConfigGroups:      make([]*api.ConfigGroup, 0, len(configGroups)),
+	}
+	for _, config := range standaloneConfigs {
+		resp.StandaloneConfigs = append(resp.StandaloneConfigs, mapStandaloneConfig(config, req.ParamFormat))
+	}
+	for _, config := range configGroups {
+		resp.ConfigGroups = append(resp.ConfigGroups, mapConfigGroup(config, req.ParamFormat))
+	}
+	return resp, nil
+}
+
+func (s *KuiperGrpcServer) RestoreConfig(ctx context.Context, req *api.RestoreConfigReq) (*api.RestoreConfigResp, error) {
+	if req.Config == nil {
+		return nil, status.Error(codes.InvalidArgument, "config must be set")
+	}
+	id := req.Config
+	switch req.ConfigType {
+	case domain.ConfTypeStandalone:
+		config, err := s.standalone.Restore(ctx, domain.Org(id.Organization), id.Namespace, id.Name, id.Version)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		return &api.RestoreConfigResp{StandaloneConfig: mapStandaloneConfig(config, id.ParamFormat)}, nil
+	case domain.ConfTypeGroup:
+		config, err := s.groups.Restore(ctx, domain.Org(id.Organization), id.Namespace, id.Name, id.Version)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		return &api.RestoreConfigResp{ConfigGroup: mapConfigGroup(config, id.ParamFormat)}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown config type: %s", req.ConfigType))
+	}
+}
+
+func (s *KuiperGrpcServer) DiffProposed(ctx context.Context, req *api.DiffProposedReq) (*api.DiffProposedResp, error) {
+	format, err := domain.ParseDiffFormat(req.Format)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	switch {
+	case req.StandaloneConfig != nil && req.ConfigGroup == nil:
+		config, _, err := mapProtoStandaloneConfig(req.StandaloneConfig)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		diff, err := s.standalone.DiffProposed(ctx, config, mapProtoConfigRef(req.Reference))
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		jsonPatch, unifiedDiff, err := renderDiff(diff, format)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		resp := &api.DiffProposedResp{
+			StandaloneConfigDiffs: make([]*api.Diff, 0),
+			JsonPatch:             jsonPatch,
+			UnifiedDiff:           unifiedDiff,
+		}
+		if format == domain.DiffFormatList {
+			resp.StandaloneConfigDiffs = mapDiffs(diff.Diffs)
+		}
+		return resp, nil
+	case req.ConfigGroup != nil && req.StandaloneConfig == nil:
+		config, _, err := mapProtoConfigGroup(req.ConfigGroup)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		diff, err := s.groups.DiffProposed(ctx, config, mapProtoConfigRef(req.Reference))
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		jsonPatch, unifiedDiff, err := renderDiff(diff, format)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		resp := &api.DiffProposedResp{
+			ConfigGroupDiffs: make(map[string]*api.Diffs),
+			JsonPatch:        jsonPatch,
+			UnifiedDiff:      unifiedDiff,
+		}
+		if format == domain.DiffFormatList {
+			resp.ConfigGroupDiffs = mapDiffsByParamSet(diff.Diffs)
+		}
+		return resp, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "exactly one of standalone config and config group must be set")
+	}
+}
+
+func (s *KuiperGrpcServer) MergeConfigs(ctx context.Context, req *api.MergeConfigsReq) (*api.MergeConfigsResp, error) {
+	if req.Ancestor == nil || req.Ours == nil || req.Theirs == nil {
+		return nil, status.Error(codes.InvalidArgument, "ancestor, ours and theirs must be set")
+	}
+	ancestor, ours, theirs := *mapProtoConfigRef(req.Ancestor), *mapProtoConfigRef(req.Ours), *mapProtoConfigRef(req.Theirs)
+	switch req.ConfigType {
+	case domain.ConfTypeStandalone:
+		config, conflicts, err := s.standalone.Merge(ctx, ancestor, ours, theirs, req.Version, req.AutoCommit)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		return &api.MergeConfigsResp{
+			StandaloneConfig: mapStandaloneConfig(config, req.Ours.ParamFormat),
+			Conflicts:        mapMergeConflicts(conflicts),
+			Committed:        req.AutoCommit && len(conflicts) == 0,
+		}, nil
+	case domain.ConfTypeGroup:
+		config, conflicts, err := s.groups.Merge(ctx, ancestor, ours, theirs, req.Version, req.AutoCommit)
+		if err := mapError(err); err != nil {
+			return nil, err
+		}
+		return &api.MergeConfigsResp{
+			ConfigGroup: mapConfigGroup(config, req.Ours.ParamFormat),
+			Conflicts:   mapMergeConflicts(conflicts),
+			Committed:   req.AutoCommit && len(conflicts) == 0,
+		}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown config type: %s", req.ConfigType))
+	}
+}
+
+func (s *KuiperGrpcServer) PatchStandaloneConfig(ctx context.Context, req *api.PatchConfigReq) (*api.StandaloneConfig, error) {
+	base := mapProtoConfigRef(req.Base)
+	if base == nil {
+		return nil, status.Error(codes.InvalidArgument, "base config must be set")
+	}
+	ops, err := mapProtoPatchOps(req.Ops)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	duplicates, err := domain.ParseDuplicateContentPolicy(req.DuplicateContent)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	config, err := s.standalone.Patch(ctx, *base, req.Version, ops, req.RequireLatest, duplicates)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapStandaloneConfig(config, req.Base.ParamFormat), nil
+}
+
+func (s *KuiperGrpcServer) PatchConfigGroup(ctx context.Context, req *api.PatchConfigReq) (*api.ConfigGroup, error) {
+	base := mapProtoConfigRef(req.Base)
+	if base == nil {
+		return nil, status.Error(codes.InvalidArgument, "base config must be set")
+	}
+	ops, err := mapProtoPatchOps(req.Ops)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	duplicates, err := domain.ParseDuplicateContentPolicy(req.DuplicateContent)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	config, err := s.groups.Patch(ctx, *base, req.Version, ops, req.RequireLatest, duplicates)
+	if err := mapError(err); err != nil {
+		return nil, err
+	}
+	return mapConfigGroup(config, req.Base.ParamFormat), nil
+}
+
+type renderableDiff interface {
+	JSONPatch() ([]domain.JSONPatchOperation, *domain.Error)
+	Unified() (string, *domain.Error)
+}
+
+// renderDiff returns the JSON Patch or the unified diff of a comparison if the format asks for one of them
+func renderDiff(diff renderableDiff, format domain.DiffFormat) (string, string, *domain.Error) {
+	switch format {
+	case domain.DiffFormatJSONPatch:
+		ops, opsErr := diff.JSONPatch()
+		if opsErr != nil {
+			return "", "", opsErr
+		}
+		patch, err := json.Marshal(ops)
+		if err != nil {
+			return "", "", domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		return string(patch), "", nil
+	case domain.DiffFormatUnified:
+		unified, err := diff.Unified()
+		return "", unified, err
+	default:
+		return "", "", nil
+	}
+}
+
+func mapDiffs(diffs []domain.Diff) []*api.Diff {
+	protoDiffs := make([]*api.Diff, 0, len(diffs))
+	for _, diff := range diffs {
+		protoDiffs = append(protoDiffs, &api.Diff{Type: string(diff.Type()), Diff: diff.Diff()})
+	}
+	return protoDiffs
+}
+
+func mapDiffsByParamSet(diffsByParamSet map[string][]domain.Diff) map[string]*api.Diffs {
+	protoDiffs := make(map[string]*api.Diffs, len(diffsByParamSet))
+	for paramSet, diffs := range diffsByParamSet {
+		protoDiffs[paramSet] = &api.Diffs{Diffs: mapDiffs(diffs)}
+	}
+	return protoDiffs
+}
+
+func mapProtoPatchOps(protoOps []*api.PatchOp) ([]domain.PatchOp, *domain.Error) {
+	ops := make([]domain.PatchOp, 0, len(protoOps))
+	for _, protoOp := range protoOps {
+		op := domain.PatchOp{
+			Type:     domain.PatchOpType(protoOp.Type),
+			ParamSet: protoOp.ParamSet,
+		}
+		if !op.Type.IsValid() {
+			return nil, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("unknown patch op type: %s", protoOp.Type))
+		}
+		if op.Type != domain.PatchOpParamSetAddition && op.Type != domain.PatchOpParamSetDeletion {
+			if protoOp.Param == nil {
+				return nil, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("%s must have a param", op.Type))
+			}
+			op.Key = protoOp.Param.Key
+			op.Value = protoOp.Param.Value
+			op.ParamType = domain.ParamType(protoOp.Param.Type)
+			op.Secret = protoOp.Param.Secret
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func mapMergeConflicts(conflicts []domain.MergeConflict) []*api.MergeConflict {
+	protoConflicts := make([]*api.MergeConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		protoConflicts = append(protoConflicts, &api.MergeConflict{
+			ParamSet: conflict.ParamSet,
+			Key:      conflict.Key,
+			Ours:     &api.Diff{Type: string(conflict.Ours.Type()), Diff: conflict.Ours.Diff()},
+			Theirs:   &api.Diff{Type: string(conflict.Theirs.Type()), Diff: conflict.Theirs.Diff()},
+		})
+	}
+	return protoConflicts
+}
+
+func mapRetentionCandidates(candidates []domain.RetentionCandidate) []*api.RetentionCandidate {
+	protoCandidates := make([]*api.RetentionCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		protoCandidates = append(protoCandidates, &api.RetentionCandidate{
+			ConfigType: candidate.ConfigType,
+			Config:     mapConfigRef(&candidate.Config),
+			CreatedAt:  time.Unix(candidate.CreatedAt, 0).UTC().String(),
+			Reason:     candidate.Reason,
+		})
+	}
+	return protoCandidates
+}
+
 func GetAuthInterceptor() func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		md, ok := metadata.FromIncomingContext(ctx)
@@ -278,6 +767,75 @@ func GetAuthInterceptor() func(ctx context.Context, req interface{}, info *grpc.
 	}
 }
 
+func GetStreamAuthInterceptor() func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		md, ok := metadata.FromIncomingContext(ctx)
+		if ok && len(md.Get("authz-token")) > 0 {
+			ctx = context.WithValue(ctx, "authz-token", md.Get("authz-token")[0])
+		}
+		return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func mapProtoStandaloneConfig(req *api.NewStandaloneConfig) (*domain.StandaloneConfig, *quasarapi.ConfigSchemaDetails, *domain.Error) {
+	paramSet, err := mapProtoParamSet(req.Name, req.ParamSet, req.ParamTree)
+	if err != nil {
+		return nil, nil, err
+	}
+	paramSet.SetRemovals(req.RemovedParams)
+	config := domain.NewStandaloneConfig(domain.Org(req.Organization), req.Namespace, req.Version, *paramSet)
+	config.SetLabels(req.Labels)
+	config.SetAnnotations(req.Annotations)
+	config.SetBase(mapProtoConfigRef(req.Base))
+	if req.Draft {
+		// the author and the required approvals are set when the draft is opened
+		config.SetDraft(&domain.Draft{})
+	}
+	var schema *quasarapi.ConfigSchemaDetails
+	if req.Schema != nil {
+		schema = &quasarapi.ConfigSchemaDetails{
+			Organization: req.Organization,
+			SchemaName:   req.Schema.Name,
+			Version:      req.Schema.Version,
+		}
+	}
+	return config, schema, nil
+}
+
+func mapProtoConfigGroup(req *api.NewConfigGroup) (*domain.ConfigGroup, *quasarapi.ConfigSchemaDetails, *domain.Error) {
+	paramSets, err := mapProtoParamSets(req.ParamSets)
+	if err != nil {
+		return nil, nil, err
+	}
+	config := domain.NewConfigGroup(domain.Org(req.Organization), req.Namespace, req.Name, req.Version, paramSets)
+	config.SetLabels(req.Labels)
+	config.SetAnnotations(req.Annotations)
+	config.SetBase(mapProtoConfigRef(req.Base))
+	if req.Draft {
+		config.SetDraft(&domain.Draft{})
+	}
+	var schema *quasarapi.ConfigSchemaDetails
+	if req.Schema != nil {
+		schema = &quasarapi.ConfigSchemaDetails{
+			Organization: req.Organization,
+			Namespace:    req.Namespace,
+			SchemaName:   req.Schema.Name,
+			Version:      req.Schema.Version,
+		}
+	}
+	return config, schema, nil
+}
+
 func mapError(err *domain.Error) error {
 	if err == nil {
 		return nil
@@ -297,44 +855,225 @@ func mapError(err *domain.Error) error {
 		return status.Error(codes.Internal, err.Message())
 	case domain.ErrTypeSchemaInvalid:
 		return status.Error(codes.InvalidArgument, err.Message())
+	case domain.ErrTypeFailedPrecondition:
+		return status.Error(codes.FailedPrecondition, err.Message())
 	default:
 		return status.Error(codes.Unknown, err.Message())
 	}
 }
 
-func mapProtoParamSet(name string, params []*api.Param) *domain.NamedParamSet {
+func mapStandaloneConfig(config *domain.StandaloneConfig, format api.ParamFormat) *api.StandaloneConfig {
+	configProto := &api.StandaloneConfig{
+		Organization:  string(config.Org()),
+		Namespace:     config.Namespace(),
+		Name:          config.Name(),
+		Version:       config.Version(),
+		CreatedAt:     config.CreatedAtUTC().String(),
+		ParamSet:      make([]*api.Param, 0),
+		Labels:        config.Labels(),
+		Annotations:   config.Annotations(),
+		Base:          mapConfigRef(config.Base()),
+		RemovedParams: config.NamedParamSet().Removals(),
+		PromotedFrom:  mapProvenance(config.PromotedFrom()),
+		ContentHash:   config.ContentHash(),
+		DuplicateOf:   config.DuplicateOf(),
+		Draft:         mapDraft(config.Draft()),
+		Deleted:       mapTombstone(config.Deleted()),
+	}
+	if tree, ok := mapParamTree(config.NamedParamSet(), format); ok {
+		configProto.ParamTree = tree
+	} else {
+		configProto.ParamSet = mapParamSet(config.NamedParamSet())
+	}
+	return configProto
+}
+
+func mapConfigGroup(config *domain.ConfigGroup, format api.ParamFormat) *api.ConfigGroup {
+	return &api.ConfigGroup{
+		Organization: string(config.Org()),
+		Namespace:    config.Namespace(),
+		Name:         config.Name(),
+		Version:      config.Version(),
+		CreatedAt:    config.CreatedAtUTC().String(),
+		ParamSets:    mapParamSets(config.ParamSets(), format),
+		Labels:       config.Labels(),
+		Annotations:  config.Annotations(),
+		Base:         mapConfigRef(config.Base()),
+		PromotedFrom: mapProvenance(config.PromotedFrom()),
+		ContentHash:  config.ContentHash(),
+		DuplicateOf:  config.DuplicateOf(),
+		Draft:        mapDraft(config.Draft()),
+		Deleted:      mapTombstone(config.Deleted()),
+	}
+}
+
+func mapProtoParamSet(name string, params []*api.Param, paramTree string) (*domain.NamedParamSet, *domain.Error) {
 	paramSet := make(map[string]string)
+	paramTypes := make(map[string]domain.ParamType)
+	secrets := make(map[string]bool)
 	for _, param := range params {
 		paramSet[param.Key] = param.Value
+		if param.Type != "" {
+			paramTypes[param.Key] = domain.ParamType(param.Type)
+		}
+		if param.Secret {
+			secrets[param.Key] = true
+		}
 	}
-	return domain.NewParamSet(name, paramSet)
+	namedParamSet := domain.NewTypedParamSet(name, paramSet, paramTypes)
+	namedParamSet.SetSecrets(secrets)
+	if paramTree == "" {
+		return namedParamSet, nil
+	}
+	tree, err := domain.ParseParamTree(paramTree)
+	if err != nil {
+		return nil, err
+	}
+	treeParamSet, err := domain.NewParamSetFromTree(name, tree)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.MergeParamSets(namedParamSet, treeParamSet); err != nil {
+		return nil, err
+	}
+	return namedParamSet, nil
 }
 
-func mapProtoParamSets(params []*api.NamedParamSet) []domain.NamedParamSet {
+func mapProtoParamSets(params []*api.NamedParamSet) ([]domain.NamedParamSet, *domain.Error) {
 	paramSets := make([]domain.NamedParamSet, 0)
 	for _, paramSet := range params {
-		paramSets = append(paramSets, *mapProtoParamSet(paramSet.Name, paramSet.ParamSet))
+		namedParamSet, err := mapProtoParamSet(paramSet.Name, paramSet.ParamSet, paramSet.ParamTree)
+		if err != nil {
+			return nil, err
+		}
+		namedParamSet.SetRemovals(paramSet.RemovedParams)
+		paramSets = append(paramSets, *namedParamSet)
 	}
-	return paramSets
+	return paramSets, nil
 }
 
-func mapParamSet(params map[string]string) []*api.Param {
-	paramSet := make([]*api.Param, 0)
-	for key, value := range params {
-		paramSet = append(paramSet, &api.Param{Key: key, Value: value})
+func mapParamSet(paramSet domain.NamedParamSet) []*api.Param {
+	params := make([]*api.Param, 0)
+	for key, value := range paramSet.ParamSet() {
+		params = append(params, &api.Param{Key: key, Value: value, Type: string(paramSet.ParamTypes()[key]), Secret: paramSet.IsSecret(key), Origin: paramSet.Origin(key)})
 	}
-	return paramSet
+	return params
 }
 
-func mapParamSets(paramSets []domain.NamedParamSet) []*api.NamedParamSet {
+func mapParamSets(paramSets []domain.NamedParamSet, format api.ParamFormat) []*api.NamedParamSet {
 	protoParamSets := make([]*api.NamedParamSet, 0)
 	for _, paramSet := range paramSets {
-		params := mapParamSet(paramSet.ParamSet())
-		protoParamSets = append(protoParamSets, &api.NamedParamSet{Name: paramSet.Name(), ParamSet: params})
+		if tree, ok := mapParamTree(paramSet, format); ok {
+			protoParamSets = append(protoParamSets, &api.NamedParamSet{Name: paramSet.Name(), ParamSet: make([]*api.Param, 0), ParamTree: tree, RemovedParams: paramSet.Removals()})
+			continue
+		}
+		params := mapParamSet(paramSet)
+		protoParamSets = append(protoParamSets, &api.NamedParamSet{Name: paramSet.Name(), ParamSet: params, RemovedParams: paramSet.Removals()})
 	}
 	return protoParamSets
 }
 
+// mapParamTree encodes the nested params as json when the nested format is requested,
+// params that can't be encoded (e.g. NaN floats) are returned flattened instead
+func mapParamTree(paramSet domain.NamedParamSet, format api.ParamFormat) (string, bool) {
+	if format != api.ParamFormat_Nested {
+		return "", false
+	}
+	tree, err := json.Marshal(paramSet.Tree())
+	if err != nil {
+		return "", false
+	}
+	return string(tree), true
+}
+
+func mapProtoConfigRef(configId *api.ConfigId) *domain.ConfigRef {
+	if configId == nil {
+		return nil
+	}
+	return &domain.ConfigRef{
+		Org:       domain.Org(configId.Organization),
+		Namespace: configId.Namespace,
+		Name:      configId.Name,
+		Version:   configId.Version,
+	}
+}
+
+func mapConfigRef(ref *domain.ConfigRef) *api.ConfigId {
+	if ref == nil {
+		return nil
+	}
+	return &api.ConfigId{
+		Organization: string(ref.Org),
+		Namespace:    ref.Namespace,
+		Name:         ref.Name,
+		Version:      ref.Version,
+	}
+}
+
+func mapDraft(draft *domain.Draft) *api.Draft {
+	if draft == nil {
+		return nil
+	}
+	return &api.Draft{
+		Author:            draft.Author,
+		RequiredApprovals: int32(draft.RequiredApprovals),
+		Approvers:         draft.Approvers,
+	}
+}
+
+func mapTombstone(tombstone *domain.Tombstone) *api.Tombstone {
+	if tombstone == nil {
+		return nil
+	}
+	return &api.Tombstone{
+		DeletedBy: tombstone.DeletedBy,
+		DeletedAt: tombstone.DeletedAtUTC().String(),
+	}
+}
+
+func mapProvenance(provenance *domain.Provenance) *api.Provenance {
+	if provenance == nil {
+		return nil
+	}
+	return &api.Provenance{
+		Source:          mapConfigRef(&provenance.Source),
+		SourceCreatedAt: provenance.CreatedAtUTC().String(),
+	}
+}
+
+func mapConfigRefs(refs []domain.ConfigRef) []*api.ConfigId {
+	configIds := make([]*api.ConfigId, 0, len(refs))
+	for _, ref := range refs {
+		configIds = append(configIds, mapConfigRef(&ref))
+	}
+	return configIds
+}
+
+func mapListOptions(pageSize int32, pageToken string, filter *api.ListFilter, sort *api.ListSort) domain.ListOptions {
+	opts := domain.ListOptions{
+		PageSize:  int(pageSize),
+		PageToken: pageToken,
+	}
+	if filter != nil {
+		opts.NamePrefix = filter.NamePrefix
+		opts.CreatedAfter = filter.CreatedAfter
+		opts.CreatedBefore = filter.CreatedBefore
+		opts.LatestOnly = filter.LatestOnly
+		for _, selector := range filter.LabelSelector {
+			opts.Selectors = append(opts.Selectors, domain.LabelSelector{
+				LabelKey: selector.LabelKey,
+				ShouldBe: domain.ComparisonOperator(selector.ShouldBe),
+				Value:    selector.Value,
+			})
+		}
+	}
+	if sort != nil {
+		opts.SortBy = domain.ListSortField(sort.By)
+		opts.SortDesc = sort.Descending
+	}
+	return opts
+}
+
 func mapTasks(tasks []domain.PlacementTask) []*api.PlacementTask {
 	protoTasks := make([]*api.PlacementTask, 0)
 	for _, task := range tasks {
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"github.com/google/uuid"
+)
+
+// AuditService records who attempted which mutation of a config and how it ended
+type AuditService struct {
+	authorizer *AuthZService
+	store      domain.AuditStore
+}
+
+func NewAuditService(authorizer *AuthZService, store domain.AuditStore) *AuditService {
+	return &AuditService{
+		authorizer: authorizer,
+		store:      store,
+	}
+}
+
+// Record appends an event with the subject of the caller's token as the actor, err is the outcome of the mutation.
+// Failing to record an event doesn't fail the mutation, it has already happened
+func (s *AuditService) Record(ctx context.Context, action domain.AuditAction, configType string, config domain.ConfigRef, err *domain.Error) {
+	actor, _ := s.authorizer.Subject(ctx)
+	s.record(ctx, actor, action, configType, config, "", err)
+}
+
+// RecordAs appends an event of a mutation kuiper makes on its own, like the removal of expired versions
+func (s *AuditService) RecordAs(ctx context.Context, actor string, action domain.AuditAction, configType string, config domain.ConfigRef, err *domain.Error) {
+	s.record(ctx, actor, action, configType, config, "", err)
+}
+
+// RecordTaskStatus appends a placement task status reported by an agent
+func (s *AuditService) RecordTaskStatus(ctx context.Context, configType string, config domain.ConfigRef, taskId string, status domain.PlacementTaskStatus, err *domain.Error) {
+	s.record(ctx, domain.AuditActorAgent, domain.AuditActionTaskStatus, configType, config, fmt.Sprintf("task %s: %s", taskId, status), err)
+}
+
+func (s *AuditService) record(ctx context.Context, actor string, action domain.AuditAction, configType string, config domain.ConfigRef, detail string, err *domain.Error) {
+	event := domain.NewAuditEvent(uuid.New().String(), actor, action, configType, config, detail, err, time.Now())
+	// the event is recorded even if the caller went away in the meantime
+	if appendErr := s.store.Append(context.WithoutCancel(ctx), event); appendErr != nil {
+		log.Printf("could not record audit event %s %s %s: %s", event.Action, event.ConfigType, event.Config, appendErr.Message())
+	}
+}
+
+func (s *AuditService) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, string, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(filter.Org)) {
+		return nil, "", domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	return s.store.List(ctx, filter)
+}
@@ -6,6 +6,8 @@ import (
 	"log"
 	"strings"
 
+	"github.com/c12s/kuiper/internal/domain"
+	oortapi "github.com/c12s/oort/pkg/api"
 	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc/metadata"
 )
@@ -13,7 +15,11 @@ import (
 const (
 	PermConfigGet = "config.get"
 	PermConfigPut = "config.put"
-	PermNsPut     = "namespace.putconfig"
+	// PermConfigReveal allows reading the plaintext values of secret params
+	PermConfigReveal = "config.reveal"
+	// PermConfigApprove allows approving and rejecting drafts
+	PermConfigApprove = "config.approve"
+	PermNsPut         = "namespace.putconfig"
 )
 
 const (
@@ -26,6 +32,44 @@ func OortConfigId(configType, org, namespace, name, version string) string {
 	return fmt.Sprintf("%s/%s/%s/%s/%s", configType, org, namespace, name, version)
 }
 
+// registerConfig makes the config inherit the permissions granted on its namespace
+func registerConfig(administrator *oortapi.AdministrationAsyncClient, config domain.Config) {
+	err := administrator.SendRequest(&oortapi.CreateInheritanceRelReq{
+		From: &oortapi.Resource{
+			Id:   fmt.Sprintf("%s/%s", config.Org(), config.Namespace()),
+			Kind: OortResNamespace,
+		},
+		To: &oortapi.Resource{
+			Id:   OortConfigId(config.Type(), string(config.Org()), config.Namespace(), config.Name(), config.Version()),
+			Kind: OortResConfig,
+		},
+	}, func(resp *oortapi.AdministrationAsyncResp) {
+		log.Println(resp.Error)
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// unregisterConfig removes the inheritance relation created by registerConfig
+func unregisterConfig(administrator *oortapi.AdministrationAsyncClient, config domain.Config) {
+	err := administrator.SendRequest(&oortapi.DeleteInheritanceRelReq{
+		From: &oortapi.Resource{
+			Id:   fmt.Sprintf("%s/%s", config.Org(), config.Namespace()),
+			Kind: OortResNamespace,
+		},
+		To: &oortapi.Resource{
+			Id:   OortConfigId(config.Type(), string(config.Org()), config.Namespace(), config.Name(), config.Version()),
+			Kind: OortResConfig,
+		},
+	}, func(resp *oortapi.AdministrationAsyncResp) {
+		log.Println(resp.Error)
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
 type AuthZService struct {
 	key string
 }
@@ -35,16 +79,8 @@ func NewAuthZService(key string) *AuthZService {
 }
 
 func (s *AuthZService) Authorize(ctx context.Context, permName string, objKind string, objId string) bool {
-	tokenString, ok := ctx.Value("authz-token").(string)
+	token, ok := s.parseToken(ctx)
 	if !ok {
-		log.Println("no token provided")
-		return false
-	}
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.key), nil
-	})
-	if err != nil {
-		log.Printf("Error parsing token: %v", err)
 		return false
 	}
 
@@ -72,6 +108,36 @@ func (s *AuthZService) Authorize(ctx context.Context, permName string, objKind s
 	return false
 }
 
+// Subject returns the subject (sub claim) of the caller's token
+func (s *AuthZService) Subject(ctx context.Context) (string, bool) {
+	token, ok := s.parseToken(ctx)
+	if !ok {
+		return "", false
+	}
+	subject, err := token.Claims.GetSubject()
+	if err != nil || subject == "" {
+		log.Println("token has no subject")
+		return "", false
+	}
+	return subject, true
+}
+
+func (s *AuthZService) parseToken(ctx context.Context) (*jwt.Token, bool) {
+	tokenString, ok := ctx.Value("authz-token").(string)
+	if !ok {
+		log.Println("no token provided")
+		return nil, false
+	}
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return []byte(s.key), nil
+	})
+	if err != nil {
+		log.Printf("Error parsing token: %v", err)
+		return nil, false
+	}
+	return token, true
+}
+
 func (s *AuthZService) SetOutgoingContext(ctx context.Context) context.Context {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c12s/kuiper/internal/domain"
+	quasarapi "github.com/c12s/quasar/proto"
+)
+
+type BatchStandaloneConfig struct {
+	Config     *domain.StandaloneConfig
+	Schema     *quasarapi.ConfigSchemaDetails
+	Duplicates domain.DuplicateContentPolicy
+}
+
+type BatchConfigGroup struct {
+	Config     *domain.ConfigGroup
+	Schema     *quasarapi.ConfigSchemaDetails
+	Duplicates domain.DuplicateContentPolicy
+}
+
+// ConfigBatchService creates versions of several configs at once, either all of them are stored or none
+type ConfigBatchService struct {
+	standalone *StandaloneConfigService
+	groups     *ConfigGroupService
+	store      domain.ConfigBatchStore
+	audit      *AuditService
+}
+
+func NewConfigBatchService(standalone *StandaloneConfigService, groups *ConfigGroupService, store domain.ConfigBatchStore, audit *AuditService) *ConfigBatchService {
+	return &ConfigBatchService{
+		standalone: standalone,
+		groups:     groups,
+		store:      store,
+		audit:      audit,
+	}
+}
+
+// PutBatch validates every item the same way a single put does (including the schema check) before anything is stored,
+// bases and referenced params must already exist, they can't be created in the same batch.
+// If any item fails nothing is stored and the errors of all failed items are returned
+func (s *ConfigBatchService) PutBatch(ctx context.Context, standaloneConfigs []BatchStandaloneConfig, configGroups []BatchConfigGroup) ([]*domain.StandaloneConfig, []*domain.ConfigGroup, []domain.BatchItemError, *domain.Error) {
+	if len(standaloneConfigs)+len(configGroups) == 0 {
+		return nil, nil, nil, domain.NewError(domain.ErrTypeSchemaInvalid, "batch must contain at least one config")
+	}
+	standaloneRefs := make([]domain.ConfigRef, 0, len(standaloneConfigs))
+	for _, item := range standaloneConfigs {
+		standaloneRefs = append(standaloneRefs, domain.ConfigRefOf(item.Config))
+	}
+	groupRefs := make([]domain.ConfigRef, 0, len(configGroups))
+	for _, item := range configGroups {
+		groupRefs = append(groupRefs, domain.ConfigRefOf(item.Config))
+	}
+	created, createdGroups, itemErrs, err := s.putBatch(ctx, standaloneConfigs, configGroups)
+	recordBatch(ctx, s.audit, standaloneRefs, groupRefs, itemErrs, err)
+	return created, createdGroups, itemErrs, err
+}
+
+func (s *ConfigBatchService) putBatch(ctx context.Context, standaloneConfigs []BatchStandaloneConfig, configGroups []BatchConfigGroup) ([]*domain.StandaloneConfig, []*domain.ConfigGroup, []domain.BatchItemError, *domain.Error) {
+	itemErrs := make([]domain.BatchItemError, 0)
+	batch := domain.ConfigBatch{
+		StandaloneConfigs: make([]*domain.StandaloneConfig, 0, len(standaloneConfigs)),
+		ConfigGroups:      make([]*domain.ConfigGroup, 0, len(configGroups)),
+	}
+
+	seen := make(map[domain.ConfigRef]bool)
+	for i, item := range standaloneConfigs {
+		ref := domain.ConfigRefOf(item.Config)
+		if seen[ref] {
+			itemErrs = append(itemErrs, duplicateBatchItemError(item.Config, i))
+			continue
+		}
+		seen[ref] = true
+		config, err := s.standalone.prepare(ctx, item.Config, item.Schema, item.Duplicates)
+		if err != nil {
+			itemErrs = append(itemErrs, domain.NewBatchItemError(item.Config, i, err))
+			continue
+		}
+		batch.StandaloneConfigs = append(batch.StandaloneConfigs, config)
+	}
+	seen = make(map[domain.ConfigRef]bool)
+	for i, item := range configGroups {
+		ref := domain.ConfigRefOf(item.Config)
+		if seen[ref] {
+			itemErrs = append(itemErrs, duplicateBatchItemError(item.Config, i))
+			continue
+		}
+		seen[ref] = true
+		config, err := s.groups.prepare(ctx, item.Config, item.Schema, item.Duplicates)
+		if err != nil {
+			itemErrs = append(itemErrs, domain.NewBatchItemError(item.Config, i, err))
+			continue
+		}
+		batch.ConfigGroups = append(batch.ConfigGroups, config)
+	}
+	if len(itemErrs) > 0 {
+		return nil, nil, itemErrs, nil
+	}
+
+	conflicts, err := s.store.PutBatch(ctx, batch)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(conflicts) > 0 {
+		return nil, nil, conflicts, nil
+	}
+
+	created := make([]*domain.StandaloneConfig, 0, len(batch.StandaloneConfigs))
+	for _, config := range batch.StandaloneConfigs {
+		config, err := s.standalone.created(ctx, config)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		created = append(created, config)
+	}
+	createdGroups := make([]*domain.ConfigGroup, 0, len(batch.ConfigGroups))
+	for _, config := range batch.ConfigGroups {
+		config, err := s.groups.created(ctx, config)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		createdGroups = append(createdGroups, config)
+	}
+	return created, createdGroups, nil, nil
+}
+
+// recordBatch records a put of every item in the audit log, items without an error of their own
+// failed together with the rest of the batch
+func recordBatch(ctx context.Context, audit *AuditService, standaloneRefs, groupRefs []domain.ConfigRef, itemErrs []domain.BatchItemError, err *domain.Error) {
+	if err == nil && len(itemErrs) > 0 {
+		err = domain.NewError(domain.ErrTypeFailedPrecondition, "another config of the batch failed")
+	}
+	itemErr := func(configType string, index int) *domain.Error {
+		for _, item := range itemErrs {
+			if item.ConfigType == configType && item.Index == index {
+				return item.Err
+			}
+		}
+		return err
+	}
+	for i, ref := range standaloneRefs {
+		audit.Record(ctx, domain.AuditActionPut, domain.ConfTypeStandalone, ref, itemErr(domain.ConfTypeStandalone, i))
+	}
+	for i, ref := range groupRefs {
+		audit.Record(ctx, domain.AuditActionPut, domain.ConfTypeGroup, ref, itemErr(domain.ConfTypeGroup, i))
+	}
+}
+
+func duplicateBatchItemError(config domain.Config, index int) domain.BatchItemError {
+	return domain.NewBatchItemError(config, index, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("version %s of %s is put more than once in the batch", config.Version(), config.Name())))
+}
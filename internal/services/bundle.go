@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+type ImportResult struct {
+	ImportedStandaloneConfigs []domain.ConfigRef
+	ImportedConfigGroups      []domain.ConfigRef
+	SkippedStandaloneConfigs  []domain.ConfigRef
+	SkippedConfigGroups       []domain.ConfigRef
+}
+
+// BundleService exports all config versions of a namespace and imports them into another namespace or instance
+type BundleService struct {
+	authorizer *AuthZService
+	standalone *StandaloneConfigService
+	groups     *ConfigGroupService
+	batches    domain.ConfigBatchStore
+	secrets    domain.SecretCipher
+	audit      *AuditService
+}
+
+func NewBundleService(authorizer *AuthZService, standalone *StandaloneConfigService, groups *ConfigGroupService, batches domain.ConfigBatchStore, secrets domain.SecretCipher, audit *AuditService) *BundleService {
+	return &BundleService{
+		authorizer: authorizer,
+		standalone: standalone,
+		groups:     groups,
+		batches:    batches,
+		secrets:    secrets,
+		audit:      audit,
+	}
+}
+
+// Export writes the stored form of every config version of the namespace into a bundle,
+// exporting secret params requires the permission to reveal them
+func (s *BundleService) Export(ctx context.Context, org domain.Org, namespace string, format domain.BundleFormat) ([]byte, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	bundle := domain.NewBundle(org, namespace)
+
+	standaloneConfigs, _, err := s.standalone.store.List(ctx, org, namespace, domain.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range standaloneConfigs {
+		if config.HasSecrets() {
+			if err := s.authorizeReveal(ctx, config); err != nil {
+				return nil, err
+			}
+			config, err = config.MapSecrets(s.secrets.Decrypt)
+			if err != nil {
+				return nil, err
+			}
+		}
+		bundle.AddStandaloneConfig(config)
+	}
+
+	configGroups, _, err := s.groups.store.List(ctx, org, namespace, domain.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range configGroups {
+		if config.HasSecrets() {
+			if err := s.authorizeReveal(ctx, config); err != nil {
+				return nil, err
+			}
+			config, err = config.MapSecrets(s.secrets.Decrypt)
+			if err != nil {
+				return nil, err
+			}
+		}
+		bundle.AddConfigGroup(config)
+	}
+
+	return domain.EncodeBundle(bundle, format)
+}
+
+// Import writes the bundled config versions into the namespace in a single batch, either all of them are stored or none.
+// Every version is prepared like a put of its own, the versions prepared before it are visible to it, so overlays
+// and references can point at versions of the same bundle (bases are ordered first). Conflicts with existing versions
+// are checked before anything is written
+func (s *BundleService) Import(ctx context.Context, org domain.Org, namespace string, encoded []byte, format domain.BundleFormat, mode domain.ImportConflictMode) (*ImportResult, *domain.Error) {
+	if mode == "" {
+		mode = domain.ImportConflictFail
+	}
+	if !slices.Contains(domain.GetImportConflictModeValues(), mode) {
+		return nil, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("unknown conflict mode: %s", mode))
+	}
+	if !s.authorizer.Authorize(ctx, PermConfigPut, OortResNamespace, string(org)+"/"+namespace) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigPut))
+	}
+	bundle, err := domain.DecodeBundle(encoded, format)
+	if err != nil {
+		return nil, err
+	}
+	standaloneConfigs, err := bundle.ToStandaloneConfigs(org, namespace)
+	if err != nil {
+		return nil, err
+	}
+	configGroups, err := bundle.ToConfigGroups(org, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{
+		ImportedStandaloneConfigs: make([]domain.ConfigRef, 0),
+		ImportedConfigGroups:      make([]domain.ConfigRef, 0),
+		SkippedStandaloneConfigs:  make([]domain.ConfigRef, 0),
+		SkippedConfigGroups:       make([]domain.ConfigRef, 0),
+	}
+	pendingStandalone := make([]*domain.StandaloneConfig, 0, len(standaloneConfigs))
+	for _, config := range standaloneConfigs {
+		existing, err := s.standalone.store.Get(ctx, org, namespace, config.Name(), config.Version())
+		if err != nil && err.ErrType() != domain.ErrTypeNotFound {
+			return nil, err
+		}
+		if err != nil {
+			pendingStandalone = append(pendingStandalone, config)
+			continue
+		}
+		existing, err = existing.MapSecrets(s.secrets.Decrypt)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkImportConflict(mode, domain.ConfigRefOf(config), domain.SameStandaloneConfig(existing, config)); err != nil {
+			return nil, err
+		}
+		result.SkippedStandaloneConfigs = append(result.SkippedStandaloneConfigs, domain.ConfigRefOf(config))
+	}
+	pendingGroups := make([]*domain.ConfigGroup, 0, len(configGroups))
+	for _, config := range configGroups {
+		existing, err := s.groups.store.Get(ctx, org, namespace, config.Name(), config.Version())
+		if err != nil && err.ErrType() != domain.ErrTypeNotFound {
+			return nil, err
+		}
+		if err != nil {
+			pendingGroups = append(pendingGroups, config)
+			continue
+		}
+		existing, err = existing.MapSecrets(s.secrets.Decrypt)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkImportConflict(mode, domain.ConfigRefOf(config), domain.SameConfigGroup(existing, config)); err != nil {
+			return nil, err
+		}
+		result.SkippedConfigGroups = append(result.SkippedConfigGroups, domain.ConfigRefOf(config))
+	}
+	if len(pendingStandalone)+len(pendingGroups) == 0 {
+		return result, nil
+	}
+
+	standaloneRefs := make([]domain.ConfigRef, 0, len(pendingStandalone))
+	for _, config := range pendingStandalone {
+		standaloneRefs = append(standaloneRefs, domain.ConfigRefOf(config))
+	}
+	groupRefs := make([]domain.ConfigRef, 0, len(pendingGroups))
+	for _, config := range pendingGroups {
+		groupRefs = append(groupRefs, domain.ConfigRefOf(config))
+	}
+	batch, itemErrs := s.prepare(ctx, pendingStandalone, pendingGroups)
+	if len(itemErrs) == 0 {
+		itemErrs, err = s.batches.PutBatch(ctx, batch)
+	}
+	recordBatch(ctx, s.audit, standaloneRefs, groupRefs, itemErrs, err)
+	if err != nil {
+		return nil, err
+	}
+	// a version created since the conflict check is never overwritten, the import is rejected as a whole
+	if len(itemErrs) > 0 {
+		item := itemErrs[0]
+		kind := "standalone config"
+		if item.ConfigType == domain.ConfTypeGroup {
+			kind = "config group"
+		}
+		ref := domain.ConfigRef{Org: org, Namespace: namespace, Name: item.Name, Version: item.Version}
+		return nil, domain.NewError(item.Err.ErrType(), fmt.Sprintf("%s %s: %s", kind, ref, item.Err.Message()))
+	}
+
+	for _, config := range batch.StandaloneConfigs {
+		if _, err := s.standalone.created(ctx, config); err != nil {
+			return nil, err
+		}
+		result.ImportedStandaloneConfigs = append(result.ImportedStandaloneConfigs, domain.ConfigRefOf(config))
+	}
+	for _, config := range batch.ConfigGroups {
+		if _, err := s.groups.created(ctx, config); err != nil {
+			return nil, err
+		}
+		result.ImportedConfigGroups = append(result.ImportedConfigGroups, domain.ConfigRefOf(config))
+	}
+	return result, nil
+}
+
+// prepare prepares the imported versions in order against staged views of the stores, imports into reviewed
+// namespaces are drafts like any other new version. Bundles don't carry content hashes since they are keyed
+// by the secrets of the instance, the hashes are computed by the preparation, the creation times are kept
+func (s *BundleService) prepare(ctx context.Context, standaloneConfigs []*domain.StandaloneConfig, configGroups []*domain.ConfigGroup) (domain.ConfigBatch, []domain.BatchItemError) {
+	stagedStandalone := stagedStandaloneConfigs{StandaloneConfigStore: s.standalone.store, staged: make(map[domain.ConfigRef]*domain.StandaloneConfig)}
+	stagedGroups := stagedConfigGroups{ConfigGroupStore: s.groups.store, staged: make(map[domain.ConfigRef]*domain.ConfigGroup)}
+	standalone := s.standalone.withStores(stagedStandalone, stagedGroups)
+	groups := s.groups.withStores(stagedStandalone, stagedGroups)
+
+	itemErrs := make([]domain.BatchItemError, 0)
+	batch := domain.ConfigBatch{
+		StandaloneConfigs: make([]*domain.StandaloneConfig, 0, len(standaloneConfigs)),
+		ConfigGroups:      make([]*domain.ConfigGroup, 0, len(configGroups)),
+	}
+	for i, config := range standaloneConfigs {
+		createdAt := config.CreatedAtUTC()
+		prepared, err := standalone.prepare(ctx, config, nil, domain.DuplicateContentAllow)
+		if err != nil {
+			itemErrs = append(itemErrs, domain.NewBatchItemError(config, i, err))
+			continue
+		}
+		prepared.SetCreatedAt(createdAt)
+		stagedStandalone.staged[domain.ConfigRefOf(prepared)] = prepared
+		batch.StandaloneConfigs = append(batch.StandaloneConfigs, prepared)
+	}
+	for i, config := range configGroups {
+		createdAt := config.CreatedAtUTC()
+		prepared, err := groups.prepare(ctx, config, nil, domain.DuplicateContentAllow)
+		if err != nil {
+			itemErrs = append(itemErrs, domain.NewBatchItemError(config, i, err))
+			continue
+		}
+		prepared.SetCreatedAt(createdAt)
+		stagedGroups.staged[domain.ConfigRefOf(prepared)] = prepared
+		batch.ConfigGroups = append(batch.ConfigGroups, prepared)
+	}
+	return batch, itemErrs
+}
+
+func (s *BundleService) authorizeReveal(ctx context.Context, config domain.Config) *domain.Error {
+	if !s.authorizer.Authorize(ctx, PermConfigReveal, OortResConfig, OortConfigId(config.Type(), string(config.Org()), config.Namespace(), config.Name(), config.Version())) {
+		return domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigReveal))
+	}
+	return nil
+}
+
+func checkImportConflict(mode domain.ImportConflictMode, ref domain.ConfigRef, identical bool) *domain.Error {
+	switch {
+	case mode == domain.ImportConflictSkip:
+		return nil
+	case mode == domain.ImportConflictIdentical && identical:
+		return nil
+	case mode == domain.ImportConflictIdentical:
+		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("config %s already exists with a different content", ref))
+	default:
+		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("config %s already exists", ref))
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/c12s/kuiper/internal/domain"
 	"github.com/c12s/kuiper/pkg/api"
+	meridian_api "github.com/c12s/meridian/pkg/api"
 	oortapi "github.com/c12s/oort/pkg/api"
 	quasarapi "github.com/c12s/quasar/proto"
 	"google.golang.org/grpc/metadata"
@@ -21,29 +22,95 @@ type ConfigGroupService struct {
 	store         domain.ConfigGroupStore
 	placements    *PlacementService
 	quasar        quasarapi.ConfigSchemaServiceClient
+	meridian      meridian_api.MeridianClient
+	secrets       domain.SecretCipher
+	interpolation *InterpolationService
+	reviews       domain.ReviewPolicy
+	audit         *AuditService
 }
 
-func NewConfigGroupService(administrator *oortapi.AdministrationAsyncClient, authorizer *AuthZService, store domain.ConfigGroupStore, placements *PlacementService, quasar quasarapi.ConfigSchemaServiceClient) *ConfigGroupService {
+func NewConfigGroupService(administrator *oortapi.AdministrationAsyncClient, authorizer *AuthZService, store domain.ConfigGroupStore, placements *PlacementService, quasar quasarapi.ConfigSchemaServiceClient, meridian meridian_api.MeridianClient, secrets domain.SecretCipher, interpolation *InterpolationService, reviews domain.ReviewPolicy, audit *AuditService) *ConfigGroupService {
 	return &ConfigGroupService{
 		administrator: administrator,
 		authorizer:    authorizer,
 		store:         store,
 		placements:    placements,
 		quasar:        quasar,
+		meridian:      meridian,
+		secrets:       secrets,
+		interpolation: interpolation,
+		reviews:       reviews,
+		audit:         audit,
 	}
 }
 
-func (s *ConfigGroupService) Put(ctx context.Context, config *domain.ConfigGroup, schema *quasarapi.ConfigSchemaDetails) (*domain.ConfigGroup, *domain.Error) {
+func (s *ConfigGroupService) Put(ctx context.Context, config *domain.ConfigGroup, schema *quasarapi.ConfigSchemaDetails, duplicates domain.DuplicateContentPolicy) (*domain.ConfigGroup, *domain.Error) {
+	config, err := s.put(ctx, config, schema, duplicates, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.created(ctx, config)
+}
+
+// put prepares and stores a new config version and records the attempt in the audit log,
+// a non-empty latest has to stay the latest version of the config until the new version is stored
+func (s *ConfigGroupService) put(ctx context.Context, config *domain.ConfigGroup, schema *quasarapi.ConfigSchemaDetails, duplicates domain.DuplicateContentPolicy, latest string) (*domain.ConfigGroup, *domain.Error) {
+	ref := domain.ConfigRefOf(config)
+	config, err := s.prepare(ctx, config, schema, duplicates)
+	if err == nil && latest == "" {
+		err = s.store.Put(ctx, config)
+	} else if err == nil {
+		err = s.store.PutIfLatest(ctx, config, latest)
+	}
+	s.audit.Record(ctx, domain.AuditActionPut, domain.ConfTypeGroup, ref, err)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// prepare authorizes and validates a new config version and returns it in the stored (encrypted) form
+func (s *ConfigGroupService) prepare(ctx context.Context, config *domain.ConfigGroup, schema *quasarapi.ConfigSchemaDetails, duplicates domain.DuplicateContentPolicy) (*domain.ConfigGroup, *domain.Error) {
+	ctx = s.authorizer.SetOutgoingContext(ctx)
+	if err := checkNamespace(ctx, s.meridian, config.Org(), config.Namespace()); err != nil {
+		return nil, err
+	}
 	if !s.authorizer.Authorize(ctx, PermConfigPut, OortResOrg, string(config.Org())) {
 		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigPut))
 	}
+	if err := domain.ValidateLabels(config.Labels()); err != nil {
+		return nil, err
+	}
+	effective := config
+	if config.Base() != nil {
+		base, err := s.loadBase(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+		base, err = base.MapSecrets(s.secrets.Decrypt)
+		if err != nil {
+			return nil, err
+		}
+		effective = config.ApplyOverlay(base)
+	}
+	// params are validated after the references are resolved, so typed params can hold references
+	effective, interpolateErr := s.interpolation.InterpolateGroup(ctx, effective)
+	if interpolateErr != nil {
+		return nil, interpolateErr
+	}
+	// params referencing secrets are secrets themselves, so the references are encrypted and redacted like them
+	secrets := make(map[string]map[string]bool)
+	for _, paramSet := range effective.ParamSets() {
+		secrets[paramSet.Name()] = paramSet.Secrets()
+	}
+	config = config.WithSecrets(secrets)
+	if err := effective.ValidateParams(); err != nil {
+		return nil, err
+	}
 	if schema != nil {
-		configMap := make(map[string]map[string]string)
-		for _, paramSet := range config.ParamSets() {
-			configMap[paramSet.Name()] = make(map[string]string)
-			for key, value := range paramSet.ParamSet() {
-				configMap[paramSet.Name()][key] = value
-			}
+		configMap := make(map[string]map[string]any)
+		for _, paramSet := range effective.ParamSets() {
+			configMap[paramSet.Name()] = paramSet.YAMLValues()
 		}
 		yamlBytes, err := yaml.Marshal(configMap)
 		if err != nil {
@@ -67,51 +134,211 @@ func (s *ConfigGroupService) Put(ctx context.Context, config *domain.ConfigGroup
 		}
 	}
 
-	config.SetCreatedAt(time.Now())
-	err := s.store.Put(ctx, config)
+	contentHash, err := effective.ComputeContentHash(s.secrets.Digest)
 	if err != nil {
 		return nil, err
 	}
-	err2 := s.administrator.SendRequest(&oortapi.CreateInheritanceRelReq{
-		From: &oortapi.Resource{
-			Id:   fmt.Sprintf("%s/%s", config.Org(), config.Namespace()),
-			Kind: OortResNamespace,
-		},
-		To: &oortapi.Resource{
-			Id:   OortConfigId(config.Type(), string(config.Org()), config.Namespace(), config.Name(), config.Version()),
-			Kind: OortResConfig,
-		},
-	}, func(resp *oortapi.AdministrationAsyncResp) {
-		log.Println(resp.Error)
-	})
-	if err2 != nil {
-		log.Println(err2)
+	config.SetContentHash(contentHash)
+	if err := checkDuplicateContent(ctx, s.store, config, duplicates); err != nil {
+		return nil, err
 	}
-	return config, nil
+	if err := openDraft(ctx, s.authorizer, s.reviews, config); err != nil {
+		return nil, err
+	}
+
+	config.SetCreatedAt(time.Now())
+	return config.MapSecrets(s.secrets.Encrypt)
 }
 
-func (s *ConfigGroupService) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
-	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(org), namespace, name, version)) {
+// withStores returns a copy of the service whose writes are prepared against the given stores
+func (s *ConfigGroupService) withStores(standalone domain.StandaloneConfigStore, groups domain.ConfigGroupStore) *ConfigGroupService {
+	staged := *s
+	staged.store = groups
+	staged.interpolation = s.interpolation.withStores(standalone, groups)
+	return &staged
+}
+
+// created registers a stored config version and returns it as it is read
+func (s *ConfigGroupService) created(ctx context.Context, config *domain.ConfigGroup) (*domain.ConfigGroup, *domain.Error) {
+	registerConfig(s.administrator, config)
+	config, err := resolveConfigGroupOverlay(ctx, s.store, config, 0)
+	if err != nil {
+		return nil, err
+	}
+	return s.revealOrRedact(ctx, config)
+}
+
+// Get returns the version as it was at the point in time, the zero point in time reads the current state
+func (s *ConfigGroupService) Get(ctx context.Context, org domain.Org, namespace, name, version string, at domain.PointInTime) (*domain.ConfigGroup, *domain.Error) {
+	store, err := s.storeAt(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+	version, err = resolveReadableVersion(ctx, s.authorizer, store, org, namespace, name, version)
+	if err != nil {
+		return nil, err
+	}
+	if !s.authorizeGet(ctx, org, namespace, name, version, at) {
 		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
-	return s.store.Get(ctx, org, namespace, name, version)
+	config, err := store.Get(ctx, org, namespace, name, version)
+	if err != nil {
+		return nil, err
+	}
+	config, err = resolveConfigGroupOverlay(ctx, store, config, 0)
+	if err != nil {
+		return nil, err
+	}
+	return s.revealOrRedact(ctx, config)
 }
 
-func (s *ConfigGroupService) List(ctx context.Context, org domain.Org, namespace string) ([]*domain.ConfigGroup, *domain.Error) {
+func (s *ConfigGroupService) List(ctx context.Context, org domain.Org, namespace string, opts domain.ListOptions, at domain.PointInTime) ([]*domain.ConfigGroup, string, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
+		return nil, "", domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	store, err := s.storeAt(ctx, at)
+	if err != nil {
+		return nil, "", err
+	}
+	configs, nextPageToken, err := store.List(ctx, org, namespace, opts)
+	if err != nil {
+		return nil, "", err
+	}
+	for i, config := range configs {
+		configs[i], err = s.revealOrRedact(ctx, config)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	return configs, nextPageToken, nil
+}
+
+func (s *ConfigGroupService) Watch(ctx context.Context, org domain.Org, namespace, name string, fromRevision int64) (<-chan domain.ConfigEvent[*domain.ConfigGroup], *domain.Error) {
 	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
 		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
-	return s.store.List(ctx, org, namespace)
+	events, err := s.store.Watch(ctx, org, namespace, name, fromRevision)
+	if err != nil {
+		return nil, err
+	}
+	return mapConfigEvents(ctx, versionEvents(ctx, events), func(config *domain.ConfigGroup) (*domain.ConfigGroup, *domain.Error) {
+		return s.revealOrRedact(ctx, config)
+	}), nil
 }
 
-func (s *ConfigGroupService) Delete(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+// Delete moves a config version to its tombstone, from which it can be restored until the grace period is over.
+// A version that is placed on any node is only deleted if the delete is forced, the base of an overlay is never deleted
+func (s *ConfigGroupService) Delete(ctx context.Context, org domain.Org, namespace, name, version string, force bool) (*domain.ConfigGroup, *domain.Error) {
+	config, err := s.delete(ctx, org, namespace, name, version, force)
+	s.audit.Record(ctx, domain.AuditActionDelete, domain.ConfTypeGroup, domain.ConfigRef{Org: org, Namespace: namespace, Name: name, Version: version}, err)
+	if err != nil {
+		return nil, err
+	}
+	return s.revealOrRedact(ctx, config)
+}
+
+func (s *ConfigGroupService) delete(ctx context.Context, org domain.Org, namespace, name, version string, force bool) (*domain.ConfigGroup, *domain.Error) {
 	if !s.authorizer.Authorize(ctx, PermConfigPut, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(org), namespace, name, version)) {
 		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigPut))
 	}
-	return s.store.Delete(ctx, org, namespace, name, version)
+	ref := domain.ConfigRef{Org: org, Namespace: namespace, Name: name, Version: version}
+	overlays, err := s.store.ListByOrg(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNotBase(ref, overlays); err != nil {
+		return nil, err
+	}
+	subject, _ := s.authorizer.Subject(ctx)
+	return deleteUnplaced(ctx, s.store, s.placements, ref, domain.ConfTypeGroup, force, domain.NewTombstone(subject, time.Now()))
+}
+
+func (s *ConfigGroupService) ListDeleted(ctx context.Context, org domain.Org, namespace string) ([]*domain.ConfigGroup, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	configs, err := s.store.ListDeleted(ctx, org, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for i, config := range configs {
+		configs[i], err = s.revealOrRedact(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return configs, nil
+}
+
+// Restore moves a deleted version back, it fails if the version has been created again since it was deleted
+func (s *ConfigGroupService) Restore(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	config, err := s.restore(ctx, org, namespace, name, version)
+	s.audit.Record(ctx, domain.AuditActionRestore, domain.ConfTypeGroup, domain.ConfigRef{Org: org, Namespace: namespace, Name: name, Version: version}, err)
+	if err != nil {
+		return nil, err
+	}
+	registerConfig(s.administrator, config)
+	config, err = resolveConfigGroupOverlay(ctx, s.store, config, 0)
+	if err != nil {
+		return nil, err
+	}
+	return s.revealOrRedact(ctx, config)
+}
+
+func (s *ConfigGroupService) restore(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	// the permission relation of the version is removed on delete, so restoring is authorized on the namespace
+	if !s.authorizer.Authorize(ctx, PermConfigPut, OortResNamespace, fmt.Sprintf("%s/%s", org, namespace)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigPut))
+	}
+	return s.store.Restore(ctx, org, namespace, name, version)
+}
+
+// Approve records the caller's approval of a draft, the draft becomes a regular version once it has enough approvals
+func (s *ConfigGroupService) Approve(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	config, err := s.review(ctx, domain.AuditActionApprove, org, namespace, name, version, approveDraft)
+	if err != nil {
+		return nil, err
+	}
+	config, err = resolveConfigGroupOverlay(ctx, s.store, config, 0)
+	if err != nil {
+		return nil, err
+	}
+	return s.revealOrRedact(ctx, config)
+}
+
+// Reject discards a draft, the rejected version is deleted like any other and can be restored during the grace period
+func (s *ConfigGroupService) Reject(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	config, err := s.review(ctx, domain.AuditActionReject, org, namespace, name, version, func(string) domain.DraftReview {
+		return rejectDraft
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.placements.removed(ctx, config)
+	return s.revealOrRedact(ctx, config)
+}
+
+// review applies the caller's review to a draft and records it in the audit log,
+// a discarded draft is tombstoned as deleted by the reviewer
+func (s *ConfigGroupService) review(ctx context.Context, action domain.AuditAction, org domain.Org, namespace, name, version string, review func(subject string) domain.DraftReview) (*domain.ConfigGroup, *domain.Error) {
+	subject, err := authorizeReview(ctx, s.authorizer, domain.ConfTypeGroup, org, namespace, name, version)
+	var config *domain.ConfigGroup
+	if err == nil {
+		config, err = s.store.ReviewDraft(ctx, org, namespace, name, version, review(subject), domain.NewTombstone(subject, time.Now()))
+	}
+	s.audit.Record(ctx, action, domain.ConfTypeGroup, domain.ConfigRef{Org: org, Namespace: namespace, Name: name, Version: version}, err)
+	return config, err
 }
 
-func (s *ConfigGroupService) Diff(ctx context.Context, referenceOrg domain.Org, referenceNamespace, referenceName, referenceVersion string, diffOrg domain.Org, diffNamespace, diffName, diffVersion string) (map[string][]domain.Diff, *domain.Error) {
+func (s *ConfigGroupService) Diff(ctx context.Context, referenceOrg domain.Org, referenceNamespace, referenceName, referenceVersion string, diffOrg domain.Org, diffNamespace, diffName, diffVersion string) (*domain.ConfigGroupDiff, *domain.Error) {
+	referenceVersion, err := s.resolveVersion(ctx, referenceOrg, referenceNamespace, referenceName, referenceVersion)
+	if err != nil {
+		return nil, err
+	}
+	diffVersion, err = s.resolveVersion(ctx, diffOrg, diffNamespace, diffName, diffVersion)
+	if err != nil {
+		return nil, err
+	}
 	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(referenceOrg), referenceNamespace, referenceName, referenceVersion)) {
 		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
@@ -126,53 +353,405 @@ func (s *ConfigGroupService) Diff(ctx context.Context, referenceOrg domain.Org,
 	if err != nil {
 		return nil, err
 	}
-	return diff.Diff(reference), nil
+	reference, err = resolveConfigGroupOverlay(ctx, s.store, reference, 0)
+	if err != nil {
+		return nil, err
+	}
+	diff, err = resolveConfigGroupOverlay(ctx, s.store, diff, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !reference.HasSecrets() && !diff.HasSecrets() {
+		return &domain.ConfigGroupDiff{Reference: reference, Target: diff, Diffs: diff.Diff(reference)}, nil
+	}
+	// secrets are compared by their plaintext since every encryption produces a different ciphertext
+	reference, err = reference.MapSecrets(s.secrets.Decrypt)
+	if err != nil {
+		return nil, err
+	}
+	diff, err = diff.MapSecrets(s.secrets.Decrypt)
+	if err != nil {
+		return nil, err
+	}
+	return redactConfigGroupDiff(diff.Diff(reference), reference, diff, s.canReveal(ctx, reference) && s.canReveal(ctx, diff))
 }
 
-func (s *ConfigGroupService) Place(ctx context.Context, org domain.Org, namespace, name, version string, strategy *api.PlaceReq_Strategy) ([]domain.PlacementTask, *domain.Error) {
-	config, err := s.store.Get(ctx, org, namespace, name, version)
+// DiffProposed compares a config that isn't stored with a stored reference version without storing anything,
+// the reference defaults to the latest version of the proposed config, or to an empty config if it has no versions yet
+func (s *ConfigGroupService) DiffProposed(ctx context.Context, proposed *domain.ConfigGroup, reference *domain.ConfigRef) (*domain.ConfigGroupDiff, *domain.Error) {
+	ref, defaulted := proposedReference(proposed, reference)
+	referenceConfig, err := s.getPlain(ctx, ref)
+	if err != nil && defaulted && err.ErrType() == domain.ErrTypeNotFound {
+		referenceConfig, err = domain.NewConfigGroup(ref.Org, ref.Namespace, ref.Name, "", nil), nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	return s.placements.Place(ctx, config, strategy, func(taskId string) ([]byte, *domain.Error) {
-		config := &api.ConfigGroup{
-			Organization: string(config.Org()),
-			Namespace:    config.Namespace(),
-			Name:         config.Name(),
-			Version:      config.Version(),
-			CreatedAt:    config.CreatedAtUTC().String(),
-			ParamSets:    mapParamSets(config.ParamSets()),
+	reveal := !referenceConfig.HasSecrets() || s.canReveal(ctx, referenceConfig)
+	effective := proposed
+	if proposed.Base() != nil {
+		base, err := s.readBase(ctx, proposed)
+		if err != nil {
+			return nil, err
 		}
-		configMarshalled, err := proto.Marshal(config)
+		// the effective proposed config holds the secrets of its base, which are only revealed to callers who can reveal the base
+		reveal = reveal && (!base.HasSecrets() || s.canReveal(ctx, base))
+		base, err = base.MapSecrets(s.secrets.Decrypt)
 		if err != nil {
-			return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+			return nil, err
 		}
-		cmd := &api.ApplyConfigCommand{
-			TaskId:    taskId,
-			Namespace: namespace,
-			Config:    configMarshalled,
-			Type:      "group",
-			Strategy:  strategy.Name,
+		effective = proposed.ApplyOverlay(base)
+	}
+	return redactConfigGroupDiff(effective.Diff(referenceConfig), referenceConfig, effective, reveal)
+}
+
+// redactConfigGroupDiff redacts the secrets of the compared configs and their diffs unless the caller can reveal them
+func redactConfigGroupDiff(diffs map[string][]domain.Diff, reference, target *domain.ConfigGroup, reveal bool) (*domain.ConfigGroupDiff, *domain.Error) {
+	if reveal {
+		return &domain.ConfigGroupDiff{Reference: reference, Target: target, Diffs: diffs}, nil
+	}
+	replaced := false
+	for paramSetName, paramSetDiffs := range diffs {
+		referenceParamSet, _ := reference.ParamSet(paramSetName)
+		targetParamSet, _ := target.ParamSet(paramSetName)
+		isSecret := func(key string) bool {
+			return referenceParamSet.IsSecret(key) || targetParamSet.IsSecret(key)
 		}
-		cmdMarshalled, err := proto.Marshal(cmd)
+		replaced = replaced || domain.SecretReplaced(paramSetDiffs, isSecret)
+		diffs[paramSetName] = domain.RedactDiffs(paramSetDiffs, isSecret)
+	}
+	reference, err := reference.MapSecrets(domain.RedactSecret)
+	if err != nil {
+		return nil, err
+	}
+	target, err = target.MapSecrets(domain.RedactSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ConfigGroupDiff{Reference: reference, Target: target, Diffs: diffs, RedactedReplacements: replaced}, nil
+}
+
+// Merge merges the changes ours and theirs made to their common ancestor into a new version of ours,
+// the merged version is only stored if commit is set and the merge has no conflicts
+func (s *ConfigGroupService) Merge(ctx context.Context, ancestor, ours, theirs domain.ConfigRef, version string, commit bool) (*domain.ConfigGroup, []domain.MergeConflict, *domain.Error) {
+	if commit && version == "" {
+		return nil, nil, domain.NewError(domain.ErrTypeSchemaInvalid, "version of the merged config must be set")
+	}
+	// a committed merge is stored in the organization of ours, so it can't copy configs of other organizations
+	if commit && (ancestor.Org != ours.Org || theirs.Org != ours.Org) {
+		return nil, nil, domain.NewError(domain.ErrTypeSchemaInvalid, "merged configs must belong to the same organization")
+	}
+	configs := make([]*domain.ConfigGroup, 0, 3)
+	for _, ref := range []domain.ConfigRef{ancestor, ours, theirs} {
+		config, err := s.getPlain(ctx, ref)
 		if err != nil {
-			return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+			return nil, nil, err
+		}
+		configs = append(configs, config)
+	}
+	paramSets, conflicts := domain.ThreeWayMergeConfigGroups(configs[0], configs[1], configs[2])
+	merged := domain.NewConfigGroup(configs[1].Org(), configs[1].Namespace(), configs[1].Name(), version, paramSets)
+	merged.SetLabels(configs[1].Labels())
+	merged.SetAnnotations(configs[1].Annotations())
+	if commit && len(conflicts) == 0 {
+		// secrets are copied in plaintext and encrypted again, so only callers who can read them may commit the merge
+		for _, config := range configs {
+			if config.HasSecrets() && !s.canReveal(ctx, config) {
+				return nil, nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigReveal))
+			}
+		}
+		config, err := s.Put(ctx, merged, nil, domain.DuplicateContentAllow)
+		return config, conflicts, err
+	}
+
+	for _, config := range configs {
+		if config.HasSecrets() && !s.canReveal(ctx, config) {
+			merged, err := merged.MapSecrets(domain.RedactSecret)
+			if err != nil {
+				return nil, nil, err
+			}
+			return merged, domain.RedactMergeConflicts(conflicts, func(paramSet, key string) bool {
+				for _, config := range configs {
+					if ps, err := config.ParamSet(paramSet); err == nil && ps.IsSecret(key) {
+						return true
+					}
+				}
+				return false
+			}), nil
+		}
+	}
+	return merged, conflicts, nil
+}
+
+// Patch stores a new version of a config with the ops applied to the effective base version, the patch of an overlay
+// stays an overlay of the same base. With requireLatest the patch fails if the base isn't the latest version of the config
+// anymore, the store checks it again when the new version is written
+func (s *ConfigGroupService) Patch(ctx context.Context, base domain.ConfigRef, version string, ops []domain.PatchOp, requireLatest bool, duplicates domain.DuplicateContentPolicy) (*domain.ConfigGroup, *domain.Error) {
+	if version == "" {
+		return nil, domain.NewError(domain.ErrTypeSchemaInvalid, "version of the patched config must be set")
+	}
+	baseVersion, err := s.resolveVersion(ctx, base.Org, base.Namespace, base.Name, base.Version)
+	if err != nil {
+		return nil, err
+	}
+	base.Version = baseVersion
+	if requireLatest {
+		latest, err := s.resolveVersion(ctx, base.Org, base.Namespace, base.Name, domain.VersionLatest)
+		if err != nil {
+			return nil, err
+		}
+		if latest != base.Version {
+			return nil, domain.NewError(domain.ErrTypeFailedPrecondition, fmt.Sprintf("base version %s is not the latest version (%s)", base.Version, latest))
+		}
+	}
+	config, err := s.getPlain(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	// secrets are copied in plaintext and encrypted again, so only callers who can read them may patch the config
+	if config.HasSecrets() && !s.canReveal(ctx, config) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigReveal))
+	}
+	var patched *domain.ConfigGroup
+	if config.Base() != nil {
+		patched, err = s.patchOverlay(ctx, base, version, ops)
+	} else {
+		patched, err = config.Patch(version, ops)
+	}
+	if err != nil {
+		return nil, err
+	}
+	latest := ""
+	if requireLatest {
+		latest = base.Version
+	}
+	patched, err = s.put(ctx, patched, nil, duplicates, latest)
+	if err != nil {
+		return nil, err
+	}
+	return s.created(ctx, patched)
+}
+
+// patchOverlay applies the ops to the stored layer of an overlay, so the patched version keeps its base
+func (s *ConfigGroupService) patchOverlay(ctx context.Context, ref domain.ConfigRef, version string, ops []domain.PatchOp) (*domain.ConfigGroup, *domain.Error) {
+	layer, err := s.store.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+	if err != nil {
+		return nil, err
+	}
+	layer, err = layer.MapSecrets(s.secrets.Decrypt)
+	if err != nil {
+		return nil, err
+	}
+	base, err := s.loadBase(ctx, layer)
+	if err != nil {
+		return nil, err
+	}
+	base, err = base.MapSecrets(s.secrets.Decrypt)
+	if err != nil {
+		return nil, err
+	}
+	return layer.PatchOverlay(base, version, ops)
+}
+
+// getPlain returns the effective config of a version with its secrets decrypted, the caller has to be able to read the version
+func (s *ConfigGroupService) getPlain(ctx context.Context, ref domain.ConfigRef) (*domain.ConfigGroup, *domain.Error) {
+	version, err := s.resolveVersion(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+	if err != nil {
+		return nil, err
+	}
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(ref.Org), ref.Namespace, ref.Name, version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	config, err := s.store.Get(ctx, ref.Org, ref.Namespace, ref.Name, version)
+	if err != nil {
+		return nil, err
+	}
+	config, err = resolveConfigGroupOverlay(ctx, s.store, config, 0)
+	if err != nil {
+		return nil, err
+	}
+	return config.MapSecrets(s.secrets.Decrypt)
+}
+
+func (s *ConfigGroupService) Place(ctx context.Context, org domain.Org, namespace, name, version string, strategy *api.PlaceReq_Strategy) ([]domain.PlacementTask, *domain.Error) {
+	tasks, err := s.place(ctx, org, namespace, name, version, strategy)
+	s.audit.Record(ctx, domain.AuditActionPlace, domain.ConfTypeGroup, domain.ConfigRef{Org: org, Namespace: namespace, Name: name, Version: version}, err)
+	return tasks, err
+}
+
+func (s *ConfigGroupService) place(ctx context.Context, org domain.Org, namespace, name, version string, strategy *api.PlaceReq_Strategy) ([]domain.PlacementTask, *domain.Error) {
+	version, err := s.resolveVersion(ctx, org, namespace, name, version)
+	if err != nil {
+		return nil, err
+	}
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(org), namespace, name, version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	config, err := s.store.Get(ctx, org, namespace, name, version)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPlaceable(config); err != nil {
+		return nil, err
+	}
+	config, err = resolveConfigGroupOverlay(ctx, s.store, config, 0)
+	if err != nil {
+		return nil, err
+	}
+	live := func() *domain.Error {
+		_, err := s.store.Get(ctx, org, namespace, name, version)
+		return err
+	}
+	// the config is resolved once, so every node of the placement gets the same content even if references change meanwhile
+	config, err = config.MapSecrets(s.secrets.Decrypt)
+	if err != nil {
+		return nil, err
+	}
+	config, err = s.interpolation.InterpolateGroup(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	configProto := &api.ConfigGroup{
+		Organization: string(config.Org()),
+		Namespace:    config.Namespace(),
+		Name:         config.Name(),
+		Version:      config.Version(),
+		CreatedAt:    config.CreatedAtUTC().String(),
+		ParamSets:    mapParamSets(config.ParamSets()),
+		Labels:       config.Labels(),
+		Annotations:  config.Annotations(),
+	}
+	configMarshalled, marshalErr := proto.Marshal(configProto)
+	if marshalErr != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, marshalErr.Error())
+	}
+	// the hash is computed over the content that is sent the same way as the stored hash, so what was applied can be
+	// compared with the hash callers read
+	contentHash, err := config.ComputeContentHash(s.secrets.Digest)
+	if err != nil {
+		return nil, err
+	}
+	return s.placements.Place(ctx, config, strategy, live, func(taskId string) ([]byte, *domain.Error) {
+		cmd := &api.ApplyConfigCommand{
+			TaskId:      taskId,
+			Namespace:   namespace,
+			Config:      configMarshalled,
+			ContentHash: contentHash,
+			Type:        "group",
+			Strategy:    strategy.Name,
+		}
+		cmdMarshalled, marshalErr := proto.Marshal(cmd)
+		if marshalErr != nil {
+			return nil, domain.NewError(domain.ErrTypeMarshalSS, marshalErr.Error())
 		}
 		return cmdMarshalled, nil
 	}, "/groups")
 }
 
 func (s *ConfigGroupService) ListPlacementTasks(ctx context.Context, org domain.Org, namespace, name, version string) ([]domain.PlacementTask, *domain.Error) {
+	version, err := s.resolveVersion(ctx, org, namespace, name, version)
+	if err != nil {
+		return nil, err
+	}
 	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(org), namespace, name, version)) {
 		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
 	return s.placements.List(ctx, org, namespace, name, version, domain.ConfTypeGroup)
 }
 
+// storeAt returns the store as it was at the point in time
+func (s *ConfigGroupService) storeAt(ctx context.Context, at domain.PointInTime) (domain.ConfigGroupStore, *domain.Error) {
+	if at.IsZero() {
+		return s.store, nil
+	}
+	revision, err := s.store.Revision(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+	return configGroupsAt{ConfigGroupStore: s.store, revision: revision}, nil
+}
+
+// authorizeGet authorizes reading a version, a version read at a past point in time may have been deleted
+// since, together with its permission relation, so past reads are authorized on the organization
+func (s *ConfigGroupService) authorizeGet(ctx context.Context, org domain.Org, namespace, name, version string, at domain.PointInTime) bool {
+	if !at.IsZero() {
+		return s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org))
+	}
+	return s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(org), namespace, name, version))
+}
+
+func (s *ConfigGroupService) resolveVersion(ctx context.Context, org domain.Org, namespace, name, version string) (string, *domain.Error) {
+	return resolveReadableVersion(ctx, s.authorizer, s.store, org, namespace, name, version)
+}
+
+// loadBase pins the base of an overlay to a concrete version and returns its effective config.
+// Reads of the overlay reveal the secrets of its base, so a base with secrets also has to be revealable
+func (s *ConfigGroupService) loadBase(ctx context.Context, config *domain.ConfigGroup) (*domain.ConfigGroup, *domain.Error) {
+	base, err := s.readBase(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if base.HasSecrets() && !s.canReveal(ctx, base) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigReveal))
+	}
+	return base, nil
+}
+
+// readBase pins the base of an overlay to a concrete version and returns its effective config,
+// the base has to belong to the same organization and the caller has to be able to read it
+func (s *ConfigGroupService) readBase(ctx context.Context, config *domain.ConfigGroup) (*domain.ConfigGroup, *domain.Error) {
+	ref := *config.Base()
+	if ref.Org == "" {
+		ref.Org = config.Org()
+	}
+	if ref.Namespace == "" {
+		ref.Namespace = config.Namespace()
+	}
+	if ref.Org != config.Org() {
+		return nil, domain.NewError(domain.ErrTypeSchemaInvalid, "base config must belong to the same organization")
+	}
+	version, err := s.resolveVersion(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+	if err != nil {
+		return nil, err
+	}
+	ref.Version = version
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(ref.Org), ref.Namespace, ref.Name, ref.Version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	base, err := s.store.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNotDraft(base, "used as a base"); err != nil {
+		return nil, err
+	}
+	base, err = resolveConfigGroupOverlay(ctx, s.store, base, 1)
+	if err != nil {
+		return nil, err
+	}
+	config.SetBase(&ref)
+	return base, nil
+}
+
+func (s *ConfigGroupService) canReveal(ctx context.Context, config *domain.ConfigGroup) bool {
+	return s.authorizer.Authorize(ctx, PermConfigReveal, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(config.Org()), config.Namespace(), config.Name(), config.Version()))
+}
+
+// revealOrRedact decrypts the secret params if the caller is allowed to reveal them, otherwise it redacts them
+func (s *ConfigGroupService) revealOrRedact(ctx context.Context, config *domain.ConfigGroup) (*domain.ConfigGroup, *domain.Error) {
+	if !config.HasSecrets() {
+		return config, nil
+	}
+	if s.canReveal(ctx, config) {
+		return config.MapSecrets(s.secrets.Decrypt)
+	}
+	return config.MapSecrets(domain.RedactSecret)
+}
+
 func mapParamSets(paramSets []domain.NamedParamSet) []*api.NamedParamSet {
 	protoParamSets := make([]*api.NamedParamSet, 0)
 	for _, paramSet := range paramSets {
-		params := mapParamSet(paramSet.ParamSet())
+		params := mapParamSet(paramSet)
 		protoParamSets = append(protoParamSets, &api.NamedParamSet{Name: paramSet.Name(), ParamSet: params})
 	}
 	return protoParamSets
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+// InterpolationService resolves param references against the configs of the referencing config's namespace
+type InterpolationService struct {
+	authorizer *AuthZService
+	standalone domain.StandaloneConfigStore
+	groups     domain.ConfigGroupStore
+	secrets    domain.SecretCipher
+}
+
+func NewInterpolationService(authorizer *AuthZService, standalone domain.StandaloneConfigStore, groups domain.ConfigGroupStore, secrets domain.SecretCipher) *InterpolationService {
+	return &InterpolationService{
+		authorizer: authorizer,
+		standalone: standalone,
+		groups:     groups,
+		secrets:    secrets,
+	}
+}
+
+// InterpolateStandalone returns a copy of the (effective, decrypted) config with all references replaced,
+// params referencing secret params are marked as secret in the copy
+func (s *InterpolationService) InterpolateStandalone(ctx context.Context, config *domain.StandaloneConfig) (*domain.StandaloneConfig, *domain.Error) {
+	owner := domain.ParamRef{ConfigType: domain.ConfTypeStandalone, Name: config.Name(), Version: config.Version()}
+	lookup := s.newLookup(ctx, config.Org(), config.Namespace(), owner)
+	lookup.standaloneConfigs[owner] = config
+	interpolator := domain.NewParamInterpolator(lookup.lookup)
+	secrets := make(map[string]bool)
+	interpolated, err := config.MapParams(func(key, _ string) (string, *domain.Error) {
+		ref := owner
+		ref.Key = key
+		value, err := interpolator.Resolve(ref)
+		secrets[key] = interpolator.IsSecret(ref)
+		return value, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return interpolated.WithSecrets(secrets), nil
+}
+
+// InterpolateGroup returns a copy of the (effective, decrypted) config with all references replaced,
+// params referencing secret params are marked as secret in the copy
+func (s *InterpolationService) InterpolateGroup(ctx context.Context, config *domain.ConfigGroup) (*domain.ConfigGroup, *domain.Error) {
+	owner := domain.ParamRef{ConfigType: domain.ConfTypeGroup, Name: config.Name(), Version: config.Version()}
+	lookup := s.newLookup(ctx, config.Org(), config.Namespace(), owner)
+	lookup.groups[owner] = config
+	interpolator := domain.NewParamInterpolator(lookup.lookup)
+	secrets := make(map[string]map[string]bool)
+	interpolated, err := config.MapParams(func(paramSet, key, _ string) (string, *domain.Error) {
+		ref := owner
+		ref.ParamSet = paramSet
+		ref.Key = key
+		value, err := interpolator.Resolve(ref)
+		if secrets[paramSet] == nil {
+			secrets[paramSet] = make(map[string]bool)
+		}
+		secrets[paramSet][key] = interpolator.IsSecret(ref)
+		return value, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return interpolated.WithSecrets(secrets), nil
+}
+
+// withStores returns a copy of the service that loads the referenced configs from the given stores
+func (s *InterpolationService) withStores(standalone domain.StandaloneConfigStore, groups domain.ConfigGroupStore) *InterpolationService {
+	return &InterpolationService{
+		authorizer: s.authorizer,
+		standalone: standalone,
+		groups:     groups,
+		secrets:    s.secrets,
+	}
+}
+
+func (s *InterpolationService) newLookup(ctx context.Context, org domain.Org, namespace string, owner domain.ParamRef) *paramLookup {
+	return &paramLookup{
+		ctx:               ctx,
+		service:           s,
+		org:               org,
+		namespace:         namespace,
+		owner:             owner,
+		standaloneConfigs: make(map[domain.ParamRef]*domain.StandaloneConfig),
+		groups:            make(map[domain.ParamRef]*domain.ConfigGroup),
+	}
+}
+
+// paramLookup loads referenced configs once per interpolation,
+// configs are keyed by their type, name and version as written in the reference
+type paramLookup struct {
+	ctx       context.Context
+	service   *InterpolationService
+	org       domain.Org
+	namespace string
+	// owner is the interpolated config, its own params are read without further permission checks
+	owner             domain.ParamRef
+	standaloneConfigs map[domain.ParamRef]*domain.StandaloneConfig
+	groups            map[domain.ParamRef]*domain.ConfigGroup
+}
+
+func (l *paramLookup) lookup(ref domain.ParamRef) (string, bool, *domain.Error) {
+	configRef := domain.ParamRef{ConfigType: ref.ConfigType, Name: ref.Name, Version: ref.Version}
+	var config domain.Config
+	var paramSet domain.NamedParamSet
+	switch ref.ConfigType {
+	case domain.ConfTypeStandalone:
+		standaloneConfig, ok := l.standaloneConfigs[configRef]
+		if !ok {
+			var err *domain.Error
+			standaloneConfig, err = l.loadStandalone(ref)
+			if err != nil {
+				return "", false, err
+			}
+			l.standaloneConfigs[configRef] = standaloneConfig
+		}
+		config = standaloneConfig
+		paramSet = standaloneConfig.NamedParamSet()
+	case domain.ConfTypeGroup:
+		group, ok := l.groups[configRef]
+		if !ok {
+			var err *domain.Error
+			group, err = l.loadGroup(ref)
+			if err != nil {
+				return "", false, err
+			}
+			l.groups[configRef] = group
+		}
+		config = group
+		var err *domain.Error
+		paramSet, err = group.ParamSet(ref.ParamSet)
+		if err != nil {
+			return "", false, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("reference ${%s}: %s", ref, err.Message()))
+		}
+	}
+	value, ok := paramSet.ParamSet()[ref.Key]
+	if !ok {
+		return "", false, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("reference ${%s}: param %s not found", ref, ref.Key))
+	}
+	secret := paramSet.IsSecret(ref.Key)
+	// a reference copies the plaintext of a secret, so it needs the permission to reveal the referenced version
+	if secret && configRef != l.owner && !l.service.authorizer.Authorize(l.ctx, PermConfigReveal, OortResConfig, OortConfigId(config.Type(), string(config.Org()), config.Namespace(), config.Name(), config.Version())) {
+		return "", false, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("reference ${%s}: Permission denied: %s", ref, PermConfigReveal))
+	}
+	return value, secret, nil
+}
+
+func (l *paramLookup) loadStandalone(ref domain.ParamRef) (*domain.StandaloneConfig, *domain.Error) {
+	store := l.service.standalone
+	version, err := resolveReadableVersion(l.ctx, l.service.authorizer, store, l.org, l.namespace, ref.Name, ref.Version)
+	if err != nil {
+		return nil, referenceError(ref, err)
+	}
+	if !l.service.authorizer.Authorize(l.ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeStandalone, string(l.org), l.namespace, ref.Name, version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("reference ${%s}: Permission denied: %s", ref, PermConfigGet))
+	}
+	config, err := store.Get(l.ctx, l.org, l.namespace, ref.Name, version)
+	if err != nil {
+		return nil, referenceError(ref, err)
+	}
+	if err := checkNotDraft(config, "referenced"); err != nil {
+		return nil, referenceError(ref, err)
+	}
+	config, err = resolveStandaloneConfigOverlay(l.ctx, store, config, 0)
+	if err != nil {
+		return nil, err
+	}
+	return config.MapSecrets(l.service.secrets.Decrypt)
+}
+
+func (l *paramLookup) loadGroup(ref domain.ParamRef) (*domain.ConfigGroup, *domain.Error) {
+	store := l.service.groups
+	version, err := resolveReadableVersion(l.ctx, l.service.authorizer, store, l.org, l.namespace, ref.Name, ref.Version)
+	if err != nil {
+		return nil, referenceError(ref, err)
+	}
+	if !l.service.authorizer.Authorize(l.ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(l.org), l.namespace, ref.Name, version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("reference ${%s}: Permission denied: %s", ref, PermConfigGet))
+	}
+	config, err := store.Get(l.ctx, l.org, l.namespace, ref.Name, version)
+	if err != nil {
+		return nil, referenceError(ref, err)
+	}
+	if err := checkNotDraft(config, "referenced"); err != nil {
+		return nil, referenceError(ref, err)
+	}
+	config, err = resolveConfigGroupOverlay(l.ctx, store, config, 0)
+	if err != nil {
+		return nil, err
+	}
+	return config.MapSecrets(l.service.secrets.Decrypt)
+}
+
+// referenceError reports missing referenced configs as invalid input of the referencing config
+func referenceError(ref domain.ParamRef, err *domain.Error) *domain.Error {
+	errType := err.ErrType()
+	if errType == domain.ErrTypeNotFound {
+		errType = domain.ErrTypeSchemaInvalid
+	}
+	return domain.NewError(errType, fmt.Sprintf("reference ${%s}: %s", ref, err.Message()))
+}
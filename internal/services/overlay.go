@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+type configVersionLister interface {
+	ListVersions(ctx context.Context, org domain.Org, namespace, name string) ([]string, *domain.Error)
+}
+
+// resolveConfigVersion returns the version matching a version query, other versions are returned as they are
+func resolveConfigVersion(ctx context.Context, store configVersionLister, org domain.Org, namespace, name, version string) (string, *domain.Error) {
+	if !domain.IsVersionQuery(version) {
+		return version, nil
+	}
+	versions, err := store.ListVersions(ctx, org, namespace, name)
+	if err != nil {
+		return "", err
+	}
+	return domain.ResolveVersion(version, versions)
+}
+
+// resolveReadableVersion resolves a version query like resolveConfigVersion, but reports a missing config
+// as NotFound only to callers who can read the namespace, so that names and versions can't be enumerated
+func resolveReadableVersion(ctx context.Context, authorizer *AuthZService, store configVersionLister, org domain.Org, namespace, name, version string) (string, *domain.Error) {
+	resolved, err := resolveConfigVersion(ctx, store, org, namespace, name, version)
+	return resolved, hideNotFound(ctx, authorizer, org, namespace, err)
+}
+
+// hideNotFound turns NotFound into Unauthorized for callers who can't read the namespace,
+// they get the same error whether a config exists or not
+func hideNotFound(ctx context.Context, authorizer *AuthZService, org domain.Org, namespace string, err *domain.Error) *domain.Error {
+	if err == nil || err.ErrType() != domain.ErrTypeNotFound {
+		return err
+	}
+	if !authorizer.Authorize(ctx, PermConfigGet, OortResNamespace, string(org)+"/"+namespace) {
+		return domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	return err
+}
+
+// proposedReference returns the version a proposed config is compared with, missing parts of the reference are
+// taken from the proposed config, defaulted is set if no reference is given and the latest version is used
+func proposedReference(proposed domain.Config, reference *domain.ConfigRef) (ref domain.ConfigRef, defaulted bool) {
+	if reference != nil {
+		ref = *reference
+	} else {
+		defaulted = true
+	}
+	if ref.Org == "" {
+		ref.Org = proposed.Org()
+	}
+	if ref.Namespace == "" {
+		ref.Namespace = proposed.Namespace()
+	}
+	if ref.Name == "" {
+		ref.Name = proposed.Name()
+	}
+	if ref.Version == "" {
+		ref.Version = domain.VersionLatest
+	}
+	return ref, defaulted
+}
+
+// resolveStandaloneConfigOverlay returns the effective config of an overlay by applying it on top of its (resolved) base
+func resolveStandaloneConfigOverlay(ctx context.Context, store domain.StandaloneConfigStore, config *domain.StandaloneConfig, depth int) (*domain.StandaloneConfig, *domain.Error) {
+	if config.Base() == nil {
+		return config, nil
+	}
+	if depth >= domain.MaxOverlayDepth {
+		return nil, domain.NewError(domain.ErrTypeInternal, fmt.Sprintf("overlays of config %s are nested deeper than %d", domain.ConfigRefOf(config), domain.MaxOverlayDepth))
+	}
+	ref := config.Base()
+	base, err := store.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+	if err != nil {
+		return nil, domain.NewError(err.ErrType(), fmt.Sprintf("base config %s of %s: %s", ref, domain.ConfigRefOf(config), err.Message()))
+	}
+	base, err = resolveStandaloneConfigOverlay(ctx, store, base, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	return config.ApplyOverlay(base), nil
+}
+
+// resolveConfigGroupOverlay returns the effective config of an overlay by applying it on top of its (resolved) base
+func resolveConfigGroupOverlay(ctx context.Context, store domain.ConfigGroupStore, config *domain.ConfigGroup, depth int) (*domain.ConfigGroup, *domain.Error) {
+	if config.Base() == nil {
+		return config, nil
+	}
+	if depth >= domain.MaxOverlayDepth {
+		return nil, domain.NewError(domain.ErrTypeInternal, fmt.Sprintf("overlays of config %s are nested deeper than %d", domain.ConfigRefOf(config), domain.MaxOverlayDepth))
+	}
+	ref := config.Base()
+	base, err := store.Get(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version)
+	if err != nil {
+		return nil, domain.NewError(err.ErrType(), fmt.Sprintf("base config %s of %s: %s", ref, domain.ConfigRefOf(config), err.Message()))
+	}
+	base, err = resolveConfigGroupOverlay(ctx, store, base, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	return config.ApplyOverlay(base), nil
+}
+
+type hashedConfig interface {
+	domain.Config
+	ContentHash() string
+	SetDuplicateOf(version string)
+}
+
+type hashedConfigStore[T hashedConfig] interface {
+	configVersionLister
+	Get(ctx context.Context, org domain.Org, namespace, name, version string) (T, *domain.Error)
+}
+
+// checkDuplicateContent compares the content hash of a new version with the latest stored version of the config,
+// depending on the policy an identical version is flagged or refused
+func checkDuplicateContent[T hashedConfig](ctx context.Context, store hashedConfigStore[T], config T, policy domain.DuplicateContentPolicy) *domain.Error {
+	if policy == domain.DuplicateContentAllow {
+		return nil
+	}
+	versions, err := store.ListVersions(ctx, config.Org(), config.Namespace(), config.Name())
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return nil
+	}
+	latestVersion := versions[0]
+	for _, version := range versions[1:] {
+		if domain.CompareVersions(version, latestVersion) > 0 {
+			latestVersion = version
+		}
+	}
+	latest, err := store.Get(ctx, config.Org(), config.Namespace(), config.Name(), latestVersion)
+	if err != nil {
+		return err
+	}
+	if latest.ContentHash() != config.ContentHash() {
+		return nil
+	}
+	if policy == domain.DuplicateContentReject {
+		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("content of version %s is identical to the latest version %s", config.Version(), latestVersion))
+	}
+	config.SetDuplicateOf(latestVersion)
+	return nil
+}
+
+// checkNotBase refuses deleting a version that live configs use as their base, they couldn't be resolved anymore
+func checkNotBase[T overlayConfig](ref domain.ConfigRef, configs []T) *domain.Error {
+	for _, config := range configs {
+		if config.Base() != nil && *config.Base() == ref {
+			return domain.NewError(domain.ErrTypeFailedPrecondition, fmt.Sprintf("config %s is the base of %s", ref, domain.ConfigRefOf(config)))
+		}
+	}
+	return nil
+}
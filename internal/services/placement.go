@@ -24,9 +24,10 @@ type PlacementService struct {
 	authorizer     *AuthZService
 	store          domain.PlacementStore
 	webhookBaseUrl string
+	audit          *AuditService
 }
 
-func NewPlacementStore(magnetar magnetarapi.MagnetarClient, aq agent_queue.AgentQueueClient, administrator *oortapi.AdministrationAsyncClient, authorizer *AuthZService, store domain.PlacementStore, webhookBaseUrl string) *PlacementService {
+func NewPlacementStore(magnetar magnetarapi.MagnetarClient, aq agent_queue.AgentQueueClient, administrator *oortapi.AdministrationAsyncClient, authorizer *AuthZService, store domain.PlacementStore, webhookBaseUrl string, audit *AuditService) *PlacementService {
 	return &PlacementService{
 		magnetar:       magnetar,
 		aq:             aq,
@@ -34,10 +35,14 @@ func NewPlacementStore(magnetar magnetarapi.MagnetarClient, aq agent_queue.Agent
 		authorizer:     authorizer,
 		store:          store,
 		webhookBaseUrl: webhookBaseUrl,
+		audit:          audit,
 	}
 }
 
-func (s *PlacementService) Place(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy, cmd func(taskId string) ([]byte, *domain.Error), webhookPath string) ([]domain.PlacementTask, *domain.Error) {
+// Place writes a task for every selected node and then disseminates the config to them. A delete of the version
+// can race with the writes, so the version is checked by live once the tasks are written and nothing is sent when it's gone,
+// the delete checks the tasks once the version is tombstoned, so at least one of them sees the other
+func (s *PlacementService) Place(ctx context.Context, config domain.Config, strategy *api.PlaceReq_Strategy, live func() *domain.Error, cmd func(taskId string) ([]byte, *domain.Error), webhookPath string) ([]domain.PlacementTask, *domain.Error) {
 	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(config.Type(), string(config.Org()), config.Namespace(), config.Name(), config.Version())) {
 		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
 	}
@@ -62,6 +67,7 @@ func (s *PlacementService) Place(ctx context.Context, config domain.Config, stra
 	}
 
 	tasks := make([]domain.PlacementTask, 0)
+	taskNodes := make([]string, 0)
 	for _, node := range nodes {
 		taskId := uuid.New().String()
 		acceptedTs := time.Now().Unix()
@@ -72,12 +78,21 @@ func (s *PlacementService) Place(ctx context.Context, config domain.Config, stra
 			continue
 		}
 		tasks = append(tasks, *task)
-		cmdMarshalled, err := cmd(taskId)
+		taskNodes = append(taskNodes, node.Id)
+	}
+	if len(tasks) > 0 {
+		if err := live(); err != nil {
+			s.withdraw(ctx, config, tasks, err)
+			return nil, err
+		}
+	}
+	for i, task := range tasks {
+		cmdMarshalled, err := cmd(task.Id())
 		if err != nil {
 			log.Println(err)
 			continue
 		}
-		deseminateErr := deseminateConfig(ctx, node.Id, cmdMarshalled, s.aq, s.webhookBaseUrl+webhookPath)
+		deseminateErr := deseminateConfig(ctx, taskNodes[i], cmdMarshalled, s.aq, s.webhookBaseUrl+webhookPath)
 		if deseminateErr != nil {
 			log.Println(deseminateErr)
 		}
@@ -85,6 +100,22 @@ func (s *PlacementService) Place(ctx context.Context, config domain.Config, stra
 	return tasks, nil
 }
 
+// withdraw takes back the tasks of a placement whose version can't be sent, the tasks of a deleted version are removed
+// like the delete does and otherwise they are failed
+func (s *PlacementService) withdraw(ctx context.Context, config domain.Config, tasks []domain.PlacementTask, cause *domain.Error) {
+	if cause.ErrType() == domain.ErrTypeNotFound {
+		if err := s.store.DeleteByConfig(ctx, config.Org(), config.Namespace(), config.Name(), config.Version(), config.Type()); err != nil {
+			log.Println(err.Message())
+		}
+		return
+	}
+	for _, task := range tasks {
+		if err := s.store.UpdateStatus(ctx, config.Org(), config.Namespace(), config.Name(), config.Version(), config.Type(), task.Id(), domain.PlacementTaskStatusFailed); err != nil {
+			log.Println(err.Message())
+		}
+	}
+}
+
 func (s *PlacementService) placeByQuery(ctx context.Context, config domain.Config, nodeQuery []*magnetarapi.Selector) ([]*magnetarapi.NodeStringified, *domain.Error) {
 	queryReq := &magnetarapi.QueryOrgOwnedNodesReq{
 		Org: string(config.Org()),
@@ -150,8 +181,75 @@ func (s *PlacementService) List(ctx context.Context, org domain.Org, namespace,
 	return s.store.ListByConfig(ctx, org, namespace, name, version, configType)
 }
 
+// checkUnplaced refuses to let a config version go while it is placed on any node, unless forced
+func (s *PlacementService) checkUnplaced(ctx context.Context, org domain.Org, namespace, name, version, configType string, force bool) *domain.Error {
+	if force {
+		return nil
+	}
+	active, err := s.activeTasks(ctx, org, namespace, name, version, configType)
+	if err != nil {
+		return err
+	}
+	if active > 0 {
+		return domain.NewError(domain.ErrTypeFailedPrecondition, fmt.Sprintf("version %s of %s has %d accepted or placed tasks, force the delete to remove its placements as well", version, name, active))
+	}
+	return nil
+}
+
+// tombstoneStore is the part of a config store that moves versions to their tombstones and back
+type tombstoneStore[T domain.Config] interface {
+	Delete(ctx context.Context, org domain.Org, namespace, name, version string, tombstone *domain.Tombstone) (T, *domain.Error)
+	Restore(ctx context.Context, org domain.Org, namespace, name, version string) (T, *domain.Error)
+}
+
+// deleteUnplaced moves a version that isn't placed (unless forced) to its tombstone and removes its placements.
+// A placement can write its tasks between the check and the delete, it checks the version after writing them
+// and the tasks are checked again after the delete, so the one that sees the other backs off
+func deleteUnplaced[T domain.Config](ctx context.Context, store tombstoneStore[T], placements *PlacementService, ref domain.ConfigRef, configType string, force bool, tombstone *domain.Tombstone) (T, *domain.Error) {
+	var zero T
+	if err := placements.checkUnplaced(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version, configType, force); err != nil {
+		return zero, err
+	}
+	config, err := store.Delete(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version, tombstone)
+	if err != nil {
+		return zero, err
+	}
+	if err := placements.checkUnplaced(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version, configType, force); err != nil {
+		if _, restoreErr := store.Restore(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version); restoreErr != nil {
+			log.Println(restoreErr.Message())
+		}
+		return zero, err
+	}
+	placements.removed(ctx, config)
+	return config, nil
+}
+
+func (s *PlacementService) activeTasks(ctx context.Context, org domain.Org, namespace, name, version, configType string) (int, *domain.Error) {
+	tasks, err := s.store.ListByConfig(ctx, org, namespace, name, version, configType)
+	if err != nil {
+		return 0, err
+	}
+	active := 0
+	for _, task := range tasks {
+		if task.Active() {
+			active++
+		}
+	}
+	return active, nil
+}
+
+// removed cleans up after a deleted config version, its placement tasks and permission relation are removed
+func (s *PlacementService) removed(ctx context.Context, config domain.Config) {
+	if err := s.store.DeleteByConfig(ctx, config.Org(), config.Namespace(), config.Name(), config.Version(), config.Type()); err != nil {
+		log.Println(err.Message())
+	}
+	unregisterConfig(s.administrator, config)
+}
+
 func (s *PlacementService) UpdateStatus(ctx context.Context, org domain.Org, namespace, name, version, configType, taskId string, status domain.PlacementTaskStatus) *domain.Error {
-	return s.store.UpdateStatus(ctx, org, namespace, name, version, configType, taskId, status)
+	err := s.store.UpdateStatus(ctx, org, namespace, name, version, configType, taskId, status)
+	s.audit.RecordTaskStatus(ctx, configType, domain.ConfigRef{Org: org, Namespace: namespace, Name: name, Version: version}, taskId, status, err)
+	return err
 }
 
 func deseminateConfig(ctx context.Context, nodeId string, cmd []byte, agentQueueClient agent_queue.AgentQueueClient, whUrl string) error {
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+// standaloneConfigsAt reads the store as it was at a revision, so that version queries and
+// overlay bases are resolved against the same state as the version that is read
+type standaloneConfigsAt struct {
+	domain.StandaloneConfigStore
+	revision int64
+}
+
+func (s standaloneConfigsAt) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
+	return s.GetAt(ctx, org, namespace, name, version, s.revision)
+}
+
+func (s standaloneConfigsAt) List(ctx context.Context, org domain.Org, namespace string, opts domain.ListOptions) ([]*domain.StandaloneConfig, string, *domain.Error) {
+	opts.Revision = s.revision
+	return s.StandaloneConfigStore.List(ctx, org, namespace, opts)
+}
+
+func (s standaloneConfigsAt) ListVersions(ctx context.Context, org domain.Org, namespace, name string) ([]string, *domain.Error) {
+	return s.ListVersionsAt(ctx, org, namespace, name, s.revision)
+}
+
+type configGroupsAt struct {
+	domain.ConfigGroupStore
+	revision int64
+}
+
+func (s configGroupsAt) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	return s.GetAt(ctx, org, namespace, name, version, s.revision)
+}
+
+func (s configGroupsAt) List(ctx context.Context, org domain.Org, namespace string, opts domain.ListOptions) ([]*domain.ConfigGroup, string, *domain.Error) {
+	opts.Revision = s.revision
+	return s.ConfigGroupStore.List(ctx, org, namespace, opts)
+}
+
+func (s configGroupsAt) ListVersions(ctx context.Context, org domain.Org, namespace, name string) ([]string, *domain.Error) {
+	return s.ListVersionsAt(ctx, org, namespace, name, s.revision)
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c12s/kuiper/internal/domain"
+	quasarapi "github.com/c12s/quasar/proto"
+)
+
+// PromotionService copies config versions into other namespaces (or organizations),
+// the copy goes through the same validation as a put in the target namespace
+type PromotionService struct {
+	standalone *StandaloneConfigService
+	groups     *ConfigGroupService
+}
+
+func NewPromotionService(standalone *StandaloneConfigService, groups *ConfigGroupService) *PromotionService {
+	return &PromotionService{
+		standalone: standalone,
+		groups:     groups,
+	}
+}
+
+// PromoteStandalone copies the effective source config into the target namespace,
+// an empty target version keeps the version of the source
+func (s *PromotionService) PromoteStandalone(ctx context.Context, source domain.ConfigRef, targetOrg domain.Org, targetNamespace, targetVersion string, schema *quasarapi.ConfigSchemaDetails, duplicates domain.DuplicateContentPolicy) (*domain.StandaloneConfig, *domain.Error) {
+	configs := s.standalone
+	version, err := configs.resolveVersion(ctx, source.Org, source.Namespace, source.Name, source.Version)
+	if err != nil {
+		return nil, err
+	}
+	if !configs.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeStandalone, string(source.Org), source.Namespace, source.Name, version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	config, err := configs.store.Get(ctx, source.Org, source.Namespace, source.Name, version)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNotDraft(config, "promoted"); err != nil {
+		return nil, err
+	}
+	config, err = resolveStandaloneConfigOverlay(ctx, configs.store, config, 0)
+	if err != nil {
+		return nil, err
+	}
+	// secrets are copied in plaintext and encrypted again, so only callers who can read them may promote them
+	if config.HasSecrets() {
+		if !configs.canReveal(ctx, config) {
+			return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigReveal))
+		}
+		config, err = config.MapSecrets(configs.secrets.Decrypt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if targetVersion == "" {
+		targetVersion = config.Version()
+	}
+
+	// the target namespace and the put permission are checked while preparing the copy
+	promoted, err := configs.put(ctx, config.PromoteTo(targetOrg, targetNamespace, targetVersion), schema, duplicates, "")
+	if err != nil {
+		return nil, err
+	}
+	return configs.created(ctx, promoted)
+}
+
+// PromoteGroup copies the effective source config into the target namespace,
+// an empty target version keeps the version of the source
+func (s *PromotionService) PromoteGroup(ctx context.Context, source domain.ConfigRef, targetOrg domain.Org, targetNamespace, targetVersion string, schema *quasarapi.ConfigSchemaDetails, duplicates domain.DuplicateContentPolicy) (*domain.ConfigGroup, *domain.Error) {
+	configs := s.groups
+	version, err := configs.resolveVersion(ctx, source.Org, source.Namespace, source.Name, source.Version)
+	if err != nil {
+		return nil, err
+	}
+	if !configs.authorizer.Authorize(ctx, PermConfigGet, OortResConfig, OortConfigId(domain.ConfTypeGroup, string(source.Org), source.Namespace, source.Name, version)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	config, err := configs.store.Get(ctx, source.Org, source.Namespace, source.Name, version)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNotDraft(config, "promoted"); err != nil {
+		return nil, err
+	}
+	config, err = resolveConfigGroupOverlay(ctx, configs.store, config, 0)
+	if err != nil {
+		return nil, err
+	}
+	if config.HasSecrets() {
+		if !configs.canReveal(ctx, config) {
+			return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigReveal))
+		}
+		config, err = config.MapSecrets(configs.secrets.Decrypt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if targetVersion == "" {
+		targetVersion = config.Version()
+	}
+
+	// the target namespace and the put permission are checked while preparing the copy
+	promoted, err := configs.put(ctx, config.PromoteTo(targetOrg, targetNamespace, targetVersion), schema, duplicates, "")
+	if err != nil {
+		return nil, err
+	}
+	return configs.created(ctx, promoted)
+}
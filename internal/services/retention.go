@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+type overlayConfig interface {
+	domain.Config
+	Base() *domain.ConfigRef
+}
+
+// RetentionService deletes the versions the retention rules of their namespaces no longer keep,
+// versions that are placed or that are the base of an overlay are never deleted.
+// It also purges the tombstones of deleted versions once their grace period is over
+type RetentionService struct {
+	authorizer  *AuthZService
+	standalone  domain.StandaloneConfigStore
+	groups      domain.ConfigGroupStore
+	placements  *PlacementService
+	audit       *AuditService
+	policy      domain.RetentionPolicy
+	gracePeriod time.Duration
+}
+
+func NewRetentionService(authorizer *AuthZService, standalone domain.StandaloneConfigStore, groups domain.ConfigGroupStore, placements *PlacementService, audit *AuditService, policy domain.RetentionPolicy, gracePeriod time.Duration) *RetentionService {
+	return &RetentionService{
+		authorizer:  authorizer,
+		standalone:  standalone,
+		groups:      groups,
+		placements:  placements,
+		audit:       audit,
+		policy:      policy,
+		gracePeriod: gracePeriod,
+	}
+}
+
+// Report returns the versions of the namespace the next collection would delete, without deleting them
+func (s *RetentionService) Report(ctx context.Context, org domain.Org, namespace string) (*domain.RetentionReport, *domain.Error) {
+	if !s.authorizer.Authorize(ctx, PermConfigGet, OortResOrg, string(org)) {
+		return nil, domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigGet))
+	}
+	rule, ok := s.policy.Rule(org, namespace)
+	if !ok {
+		return nil, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("namespace %s/%s has no retention rule", org, namespace))
+	}
+	return s.plan(ctx, org, namespace, rule, time.Now())
+}
+
+// Run collects expired versions every interval until the context is done
+func (s *RetentionService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Collect(ctx)
+		}
+	}
+}
+
+// Collect deletes the expired versions of every namespace that has a retention rule and purges expired tombstones
+func (s *RetentionService) Collect(ctx context.Context) {
+	defer s.purge(ctx)
+	for _, namespace := range s.policy.Namespaces() {
+		rule, _ := s.policy.Rule(namespace.Org, namespace.Namespace)
+		report, err := s.plan(ctx, namespace.Org, namespace.Namespace, rule, time.Now())
+		if err != nil {
+			log.Printf("retention of %s/%s: %s", namespace.Org, namespace.Namespace, err.Message())
+			continue
+		}
+		for _, candidate := range report.Expired {
+			s.collect(ctx, candidate)
+		}
+	}
+}
+
+// collect deletes an expired version like a delete does, the version may have been placed since the report was made
+func (s *RetentionService) collect(ctx context.Context, candidate domain.RetentionCandidate) {
+	ref := candidate.Config
+	tombstone := domain.NewTombstone(domain.AuditActorRetention, time.Now())
+	var err *domain.Error
+	if candidate.ConfigType == domain.ConfTypeStandalone {
+		_, err = deleteUnplaced(ctx, s.standalone, s.placements, ref, candidate.ConfigType, false, tombstone)
+	} else {
+		_, err = deleteUnplaced(ctx, s.groups, s.placements, ref, candidate.ConfigType, false, tombstone)
+	}
+	s.audit.RecordAs(ctx, domain.AuditActorRetention, domain.AuditActionDelete, candidate.ConfigType, ref, err)
+	if err != nil {
+		log.Printf("retention of %s %s: %s", candidate.ConfigType, ref, err.Message())
+	}
+}
+
+func (s *RetentionService) purge(ctx context.Context) {
+	now := time.Now()
+	standaloneConfigs, err := s.standalone.PurgeDeleted(ctx, s.gracePeriod, now)
+	for _, config := range standaloneConfigs {
+		s.audit.RecordAs(ctx, domain.AuditActorRetention, domain.AuditActionPurge, domain.ConfTypeStandalone, domain.ConfigRefOf(config), nil)
+	}
+	if err != nil {
+		log.Printf("purging deleted standalone configs: %s", err.Message())
+	}
+	configGroups, err := s.groups.PurgeDeleted(ctx, s.gracePeriod, now)
+	for _, config := range configGroups {
+		s.audit.RecordAs(ctx, domain.AuditActorRetention, domain.AuditActionPurge, domain.ConfTypeGroup, domain.ConfigRefOf(config), nil)
+	}
+	if err != nil {
+		log.Printf("purging deleted config groups: %s", err.Message())
+	}
+}
+
+func (s *RetentionService) plan(ctx context.Context, org domain.Org, namespace string, rule domain.RetentionRule, now time.Time) (*domain.RetentionReport, *domain.Error) {
+	report := &domain.RetentionReport{
+		Rule:    rule,
+		Expired: make([]domain.RetentionCandidate, 0),
+		Kept:    make([]domain.RetentionCandidate, 0),
+	}
+
+	standaloneConfigs, _, err := s.standalone.List(ctx, org, namespace, domain.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// overlays can reference bases in any namespace of the organization
+	orgStandaloneConfigs, err := s.standalone.ListByOrg(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	err = classifyExpired(ctx, s.placements, report, domain.ExpiredVersions(standaloneConfigs, rule, now), baseRefs(orgStandaloneConfigs))
+	if err != nil {
+		return nil, err
+	}
+
+	configGroups, _, err := s.groups.List(ctx, org, namespace, domain.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	orgConfigGroups, err := s.groups.ListByOrg(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	err = classifyExpired(ctx, s.placements, report, domain.ExpiredVersions(configGroups, rule, now), baseRefs(orgConfigGroups))
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func classifyExpired[T domain.Config](ctx context.Context, placements *PlacementService, report *domain.RetentionReport, expired []T, bases map[domain.ConfigRef]bool) *domain.Error {
+	for _, config := range expired {
+		ref := domain.ConfigRefOf(config)
+		candidate := domain.RetentionCandidate{
+			ConfigType: config.Type(),
+			Config:     ref,
+			CreatedAt:  config.CreatedAtUnixSec(),
+		}
+		active, err := placements.activeTasks(ctx, ref.Org, ref.Namespace, ref.Name, ref.Version, config.Type())
+		if err != nil {
+			return err
+		}
+		switch {
+		case active > 0:
+			candidate.Reason = fmt.Sprintf("%d accepted or placed tasks", active)
+			report.Kept = append(report.Kept, candidate)
+		case bases[ref]:
+			candidate.Reason = "base of an overlay"
+			report.Kept = append(report.Kept, candidate)
+		default:
+			report.Expired = append(report.Expired, candidate)
+		}
+	}
+	return nil
+}
+
+func baseRefs[T overlayConfig](configs []T) map[domain.ConfigRef]bool {
+	bases := make(map[domain.ConfigRef]bool)
+	for _, config := range configs {
+		if config.Base() != nil {
+			bases[*config.Base()] = true
+		}
+	}
+	return bases
+}
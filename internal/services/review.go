@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+type draftConfig interface {
+	domain.Config
+	Draft() *domain.Draft
+	SetDraft(draft *domain.Draft)
+}
+
+// openDraft turns a new version into a draft authored by the caller if its namespace requires review
+// or if a draft was requested, such a version can't be placed until it is approved
+func openDraft(ctx context.Context, authorizer *AuthZService, policy domain.ReviewPolicy, config draftConfig) *domain.Error {
+	requiredApprovals := policy.RequiredApprovals(config.Org(), config.Namespace())
+	if requiredApprovals == 0 && config.Draft() == nil {
+		return nil
+	}
+	author, ok := authorizer.Subject(ctx)
+	if !ok {
+		return domain.NewError(domain.ErrTypeUnauthorized, "drafts can only be created with a token that has a subject")
+	}
+	config.SetDraft(domain.NewDraft(author, requiredApprovals))
+	return nil
+}
+
+// authorizeReview returns the subject of the caller if they may review the version
+func authorizeReview(ctx context.Context, authorizer *AuthZService, configType string, org domain.Org, namespace, name, version string) (string, *domain.Error) {
+	if !authorizer.Authorize(ctx, PermConfigApprove, OortResConfig, OortConfigId(configType, string(org), namespace, name, version)) {
+		return "", domain.NewError(domain.ErrTypeUnauthorized, fmt.Sprintf("Permission denied: %s", PermConfigApprove))
+	}
+	subject, ok := authorizer.Subject(ctx)
+	if !ok {
+		return "", domain.NewError(domain.ErrTypeUnauthorized, "reviews can only be made with a token that has a subject")
+	}
+	return subject, nil
+}
+
+func approveDraft(subject string) domain.DraftReview {
+	return func(draft *domain.Draft) (domain.ReviewDecision, *domain.Error) {
+		if err := draft.Approve(subject); err != nil {
+			return domain.ReviewKeep, err
+		}
+		if draft.Approved() {
+			return domain.ReviewApprove, nil
+		}
+		return domain.ReviewKeep, nil
+	}
+}
+
+func rejectDraft(*domain.Draft) (domain.ReviewDecision, *domain.Error) {
+	return domain.ReviewDiscard, nil
+}
+
+func checkPlaceable(config draftConfig) *domain.Error {
+	return checkNotDraft(config, "placed")
+}
+
+// checkNotDraft refuses a draft as the source of placements or of other versions, its content isn't approved yet
+func checkNotDraft(config draftConfig, use string) *domain.Error {
+	if config.Draft() != nil {
+		return domain.NewError(domain.ErrTypeFailedPrecondition, fmt.Sprintf("version %s of %s is a draft waiting for approval and can't be %s", config.Version(), config.Name(), use))
+	}
+	return nil
+}
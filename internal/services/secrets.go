@@ -0,0 +1,140 @@
+package services
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+// envelopePrefix marks values encrypted by the secret service,
+// the rest of the value is the wrapped data key and the ciphertext
+const envelopePrefix = "enc:v1:"
+
+const dataKeySize = 32
+
+// digestKeyLabel derives the key of secret digests from the key-encryption key, so the key-encryption key itself is never used for them
+const digestKeyLabel = "kuiper secret digest v1"
+
+// SecretService implements envelope encryption of secret params,
+// every value is encrypted with its own data key which is wrapped by the key-encryption key
+type SecretService struct {
+	kek       cipher.AEAD
+	digestKey []byte
+}
+
+// NewSecretService creates the service from the key-encryption key,
+// without a key secret params are rejected
+func NewSecretService(kek []byte) (*SecretService, error) {
+	if len(kek) == 0 {
+		return &SecretService{}, nil
+	}
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key-encryption key: %w", err)
+	}
+	mac := hmac.New(sha256.New, kek)
+	mac.Write([]byte(digestKeyLabel))
+	return &SecretService{kek: aead, digestKey: mac.Sum(nil)}, nil
+}
+
+func (s *SecretService) Encrypt(plaintext string) (string, *domain.Error) {
+	if s.kek == nil {
+		return "", domain.NewError(domain.ErrTypeSchemaInvalid, "secret params are not supported, no key-encryption key is configured")
+	}
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	wrappedKey, err := seal(s.kek, dataKey)
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	ciphertext, err := seal(dataAEAD, []byte(plaintext))
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	return envelopePrefix + base64.RawStdEncoding.EncodeToString(wrappedKey) + ":" + base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+func (s *SecretService) Decrypt(ciphertext string) (string, *domain.Error) {
+	if s.kek == nil {
+		return "", domain.NewError(domain.ErrTypeInternal, "secret params can't be decrypted, no key-encryption key is configured")
+	}
+	encoded, ok := strings.CutPrefix(ciphertext, envelopePrefix)
+	if !ok {
+		return "", domain.NewError(domain.ErrTypeInternal, "secret param is not encrypted")
+	}
+	encodedKey, encodedValue, ok := strings.Cut(encoded, ":")
+	if !ok {
+		return "", domain.NewError(domain.ErrTypeInternal, "malformed encrypted secret param")
+	}
+	wrappedKey, err := base64.RawStdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	value, err := base64.RawStdEncoding.DecodeString(encodedValue)
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	dataKey, err := unseal(s.kek, wrappedKey)
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeInternal, fmt.Sprintf("unwrapping data key: %s", err.Error()))
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	plaintext, err := unseal(dataAEAD, value)
+	if err != nil {
+		return "", domain.NewError(domain.ErrTypeInternal, err.Error())
+	}
+	return string(plaintext), nil
+}
+
+// Digest returns an HMAC-SHA256 of the plaintext under a key derived from the key-encryption key
+func (s *SecretService) Digest(plaintext string) (string, *domain.Error) {
+	if s.kek == nil {
+		return "", domain.NewError(domain.ErrTypeSchemaInvalid, "secret params are not supported, no key-encryption key is configured")
+	}
+	mac := hmac.New(sha256.New, s.digestKey)
+	mac.Write([]byte(plaintext))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext and prepends the random nonce to the result
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func unseal(aead cipher.AEAD, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
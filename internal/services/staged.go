@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+// stagedStandaloneConfigs reads the versions prepared for a write that hasn't happened yet together with the stored ones,
+// so the versions of an import can be validated against each other before any of them is stored
+type stagedStandaloneConfigs struct {
+	domain.StandaloneConfigStore
+	staged map[domain.ConfigRef]*domain.StandaloneConfig
+}
+
+func (s stagedStandaloneConfigs) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
+	if config, ok := s.staged[domain.ConfigRef{Org: org, Namespace: namespace, Name: name, Version: version}]; ok {
+		return config, nil
+	}
+	return s.StandaloneConfigStore.Get(ctx, org, namespace, name, version)
+}
+
+func (s stagedStandaloneConfigs) ListVersions(ctx context.Context, org domain.Org, namespace, name string) ([]string, *domain.Error) {
+	versions, err := s.StandaloneConfigStore.ListVersions(ctx, org, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	for ref, config := range s.staged {
+		if ref.Org == org && ref.Namespace == namespace && ref.Name == name && config.Draft() == nil {
+			versions = append(versions, ref.Version)
+		}
+	}
+	return versions, nil
+}
+
+type stagedConfigGroups struct {
+	domain.ConfigGroupStore
+	staged map[domain.ConfigRef]*domain.ConfigGroup
+}
+
+func (s stagedConfigGroups) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	if config, ok := s.staged[domain.ConfigRef{Org: org, Namespace: namespace, Name: name, Version: version}]; ok {
+		return config, nil
+	}
+	return s.ConfigGroupStore.Get(ctx, org, namespace, name, version)
+}
+
+func (s stagedConfigGroups) ListVersions(ctx context.Context, org domain.Org, namespace, name string) ([]string, *domain.Error) {
+	versions, err := s.ConfigGroupStore.ListVersions(ctx, org, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	for ref, config := range s.staged {
+		if ref.Org == org && ref.Namespace == namespace && ref.Name == name && config.Draft() == nil {
+			versions = append(versions, ref.Version)
+		}
+	}
+	return versions, nil
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+
+	"github.com/c12s/kuiper/internal/domain"
+)
+
+// mapConfigEvents applies fn to the config of every event read from the store,
+// a mapping error is forwarded as an event error
+func mapConfigEvents[T domain.Config](ctx context.Context, events <-chan domain.ConfigEvent[T], fn func(T) (T, *domain.Error)) <-chan domain.ConfigEvent[T] {
+	mapped := make(chan domain.ConfigEvent[T])
+	go func() {
+		defer close(mapped)
+		for event := range events {
+			if event.Err == nil {
+				event.Config, event.Err = fn(event.Config)
+			}
+			select {
+			case mapped <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return mapped
+}
+
+// versionEvents drops the events of drafts, which only become versions when they are approved.
+// Stored versions are only changed by reviews, so the update that approves a draft is the creation of its version
+func versionEvents[T draftConfig](ctx context.Context, events <-chan domain.ConfigEvent[T]) <-chan domain.ConfigEvent[T] {
+	versions := make(chan domain.ConfigEvent[T])
+	go func() {
+		defer close(versions)
+		for event := range events {
+			if event.Err == nil {
+				if event.Config.Draft() != nil {
+					continue
+				}
+				if event.Type == domain.ConfigEventUpdated {
+					event.Type = domain.ConfigEventCreated
+				}
+			}
+			select {
+			case versions <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return versions
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/c12s/kuiper/internal/domain"
 	"github.com/c12s/kuiper/internal/services"
 	"github.com/c12s/kuiper/internal/store"
 	"github.com/gorilla/mux"
@@ -26,6 +27,8 @@ type app struct {
 	config            *configs.Config
 	grpcServer        *grpc.Server
 	taskWebhooks      *http.Server
+	retention         *services.RetentionService
+	stopRetention     context.CancelFunc
 	shutdownProcesses []func()
 }
 
@@ -40,15 +43,6 @@ func NewAppWithConfig(config *configs.Config) (*app, error) {
 }
 
 func (a *app) init() {
-	etcdConn, err := NewEtcdConn(a.config.EtcdAddress())
-	if err != nil {
-		log.Fatalln(err)
-	}
-	a.shutdownProcesses = append(a.shutdownProcesses, func() {
-		log.Println("closing etcd conn")
-		etcdConn.Close()
-	})
-
 	magnetarClient, err := newMagnetarClient(a.config.MagnetarAddress())
 	if err != nil {
 		log.Fatalln(err)
@@ -76,16 +70,35 @@ func (a *app) init() {
 
 	authzService := services.NewAuthZService(a.config.TokenKey())
 
-	standaloneConfigStore := store.NewStandaloneConfigEtcdStore(etcdConn)
-	configGroupStore := store.NewConfigGroupEtcdStore(etcdConn)
-	placementStore := store.NewPlacementEtcdStore(etcdConn)
+	secretService, err := newSecretService(a.config.SecretsKeyFile())
+	if err != nil {
+		log.Fatalln(err)
+	}
 
-	placementService := services.NewPlacementStore(magnetarClient, agentQueueClient, administratorClient, authzService, placementStore, a.config.WebhookUrl())
-	standaloneConfigService := services.NewStandaloneConfigService(administratorClient, authzService, standaloneConfigStore, placementService, quasarClient, meridian)
-	configGroupService := services.NewConfigGroupService(administratorClient, authzService, configGroupStore, placementService, quasarClient)
+	reviewPolicy, reviewErr := domain.ParseReviewPolicy(a.config.ReviewPolicy())
+	if reviewErr != nil {
+		log.Fatalln(reviewErr.Message())
+	}
+	retentionPolicy, retentionErr := domain.ParseRetentionPolicy(a.config.RetentionPolicy())
+	if retentionErr != nil {
+		log.Fatalln(retentionErr.Message())
+	}
 
-	kuiperGrpcServer := servers.NewKuiperServer(standaloneConfigService, configGroupService)
-	s := grpc.NewServer(grpc.UnaryInterceptor(servers.GetAuthInterceptor()))
+	standaloneConfigStore, configGroupStore, configBatchStore, placementStore, auditStore := a.initStores()
+
+	auditService := services.NewAuditService(authzService, auditStore)
+	interpolationService := services.NewInterpolationService(authzService, standaloneConfigStore, configGroupStore, secretService)
+	placementService := services.NewPlacementStore(magnetarClient, agentQueueClient, administratorClient, authzService, placementStore, a.config.WebhookUrl(), auditService)
+	standaloneConfigService := services.NewStandaloneConfigService(administratorClient, authzService, standaloneConfigStore, placementService, quasarClient, meridian, secretService, interpolationService, reviewPolicy, auditService)
+	configGroupService := services.NewConfigGroupService(administratorClient, authzService, configGroupStore, placementService, quasarClient, meridian, secretService, interpolationService, reviewPolicy, auditService)
+	bundleService := services.NewBundleService(authzService, standaloneConfigService, configGroupService, configBatchStore, secretService, auditService)
+	configBatchService := services.NewConfigBatchService(standaloneConfigService, configGroupService, configBatchStore, auditService)
+	promotionService := services.NewPromotionService(standaloneConfigService, configGroupService)
+	retentionService := services.NewRetentionService(authzService, standaloneConfigStore, configGroupStore, placementService, auditService, retentionPolicy, a.config.TombstoneGracePeriod())
+	a.retention = retentionService
+
+	kuiperGrpcServer := servers.NewKuiperServer(standaloneConfigService, configGroupService, bundleService, configBatchService, promotionService, auditService, retentionService)
+	s := grpc.NewServer(grpc.UnaryInterceptor(servers.GetAuthInterceptor()), grpc.StreamInterceptor(servers.GetStreamAuthInterceptor()))
 	api.RegisterKuiperServer(s, kuiperGrpcServer)
 	reflection.Register(s)
 	a.grpcServer = s
@@ -100,6 +113,49 @@ func (a *app) init() {
 	}
 }
 
+func (a *app) initStores() (domain.StandaloneConfigStore, domain.ConfigGroupStore, domain.ConfigBatchStore, domain.PlacementStore, domain.AuditStore) {
+	switch a.config.StoreBackend() {
+	case configs.StoreBackendInMem:
+		standaloneConfigStore, configGroupStore, configBatchStore := store.NewConfigInMemStores()
+		return standaloneConfigStore, configGroupStore, configBatchStore, store.NewPlacementInMemStore(), store.NewAuditInMemStore()
+	case configs.StoreBackendBolt:
+		db, err := NewBoltDB(a.config.BoltPath())
+		if err != nil {
+			log.Fatalln(err)
+		}
+		a.shutdownProcesses = append(a.shutdownProcesses, func() {
+			log.Println("closing bolt db")
+			db.Close()
+		})
+		standaloneConfigStore, configGroupStore, configBatchStore, err := store.NewConfigBoltStores(db)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		placementStore, err := store.NewPlacementBoltStore(db)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		auditStore, err := store.NewAuditBoltStore(db)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		return standaloneConfigStore, configGroupStore, configBatchStore, placementStore, auditStore
+	case configs.StoreBackendEtcd:
+		etcdConn, err := NewEtcdConn(a.config.EtcdAddress())
+		if err != nil {
+			log.Fatalln(err)
+		}
+		a.shutdownProcesses = append(a.shutdownProcesses, func() {
+			log.Println("closing etcd conn")
+			etcdConn.Close()
+		})
+		return store.NewStandaloneConfigEtcdStore(etcdConn), store.NewConfigGroupEtcdStore(etcdConn), store.NewConfigBatchEtcdStore(etcdConn), store.NewPlacementEtcdStore(etcdConn), store.NewAuditEtcdStore(etcdConn)
+	default:
+		log.Fatalf("unknown store backend: %s", a.config.StoreBackend())
+		return nil, nil, nil, nil, nil
+	}
+}
+
 func (a *app) startGrpcServer() error {
 	lis, err := net.Listen("tcp", a.config.ServerAddress())
 	if err != nil {
@@ -119,9 +175,16 @@ func (a *app) startWebhooks() {
 	log.Println(err)
 }
 
+func (a *app) startRetention() {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.stopRetention = cancel
+	go a.retention.Run(ctx, a.config.RetentionInterval())
+}
+
 func (a *app) Start() error {
 	a.init()
 	go a.startWebhooks()
+	a.startRetention()
 	return a.startGrpcServer()
 }
 
@@ -133,6 +196,8 @@ func (a *app) GracefulStop() {
 		log.Println(err)
 	}
 	a.grpcServer.GracefulStop()
+	// the collector is stopped before the stores are closed
+	a.stopRetention()
 	for _, shudownProcess := range a.shutdownProcesses {
 		shudownProcess()
 	}
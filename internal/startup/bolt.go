@@ -0,0 +1,13 @@
+package startup
+
+import (
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+func NewBoltDB(path string) (*bolt.DB, error) {
+	return bolt.Open(path, 0600, &bolt.Options{
+		Timeout: 5 * time.Second,
+	})
+}
@@ -0,0 +1,26 @@
+package startup
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+
+	"github.com/c12s/kuiper/internal/services"
+)
+
+// newSecretService loads the key-encryption key from the key file,
+// the file holds the base64 encoded AES key (16, 24 or 32 bytes)
+func newSecretService(keyFile string) (*services.SecretService, error) {
+	if keyFile == "" {
+		return services.NewSecretService(nil)
+	}
+	content, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(content)))
+	if err != nil {
+		return nil, err
+	}
+	return services.NewSecretService(key)
+}
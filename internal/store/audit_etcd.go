@@ -0,0 +1,217 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type AuditEtcdStore struct {
+	client *clientv3.Client
+}
+
+func NewAuditEtcdStore(client *clientv3.Client) domain.AuditStore {
+	return AuditEtcdStore{
+		client: client,
+	}
+}
+
+func (s AuditEtcdStore) Append(ctx context.Context, event domain.AuditEvent) *domain.Error {
+	dao := toAuditEventDAO(event)
+	key, orgKey := dao.Key(), dao.KeyByOrg()
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+
+	// events are never overwritten, even if an id were reused
+	resp, err := s.client.Txn(ctx).
+		If(clientv3.Compare(clientv3.CreateRevision(key), "=", 0), clientv3.Compare(clientv3.CreateRevision(orgKey), "=", 0)).
+		Then(clientv3.OpPut(key, value), clientv3.OpPut(orgKey, value)).
+		Commit()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !resp.Succeeded {
+		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("audit event (id=%s) already exists", event.Id))
+	}
+	return nil
+}
+
+func (s AuditEtcdStore) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, string, *domain.Error) {
+	if err := filter.Validate(); err != nil {
+		return nil, "", err
+	}
+	// the keys under the prefix are ordered by time, so only the range of the time bounds
+	// after the page token is scanned, in batches of the page size
+	dao := AuditEventDAO{
+		Org:       string(filter.Org),
+		Namespace: filter.Namespace,
+	}
+	prefix := dao.KeyPrefixByOrgIndex()
+	if filter.Namespace != "" {
+		prefix = dao.KeyPrefixByNamespace()
+	}
+	start, end := prefix, clientv3.GetPrefixRangeEnd(prefix)
+	if filter.From > 0 {
+		start = prefix + auditTimestampKey(time.Unix(filter.From, 0))
+	}
+	if filter.To > 0 {
+		end = prefix + auditTimestampKey(time.Unix(filter.To, 0))
+	}
+	if filter.PageToken != "" {
+		tokenKey, err := domain.DecodePageToken(filter.PageToken, prefix)
+		if err != nil {
+			return nil, "", err
+		}
+		if tokenKey+"\x00" > start {
+			start = tokenKey + "\x00"
+		}
+	}
+
+	events := make([]domain.AuditEvent, 0, filter.PageSize)
+	for {
+		resp, err := s.client.KV.Get(ctx, start, clientv3.WithRange(end), clientv3.WithLimit(int64(filter.PageSize)), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+		if err != nil {
+			return nil, "", domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		for i, kv := range resp.Kvs {
+			dao, err := NewAuditEventDAO(kv.Value)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			event := dao.toDomain()
+			if !filter.Matches(event) {
+				continue
+			}
+			events = append(events, event)
+			if len(events) == filter.PageSize {
+				if i == len(resp.Kvs)-1 && !resp.More {
+					return events, "", nil
+				}
+				return events, domain.EncodePageToken(string(kv.Key)), nil
+			}
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			return events, "", nil
+		}
+		start = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+type AuditEventDAO struct {
+	Id         string
+	Actor      string
+	Action     string
+	ConfigType string
+	Org        string
+	Namespace  string
+	Name       string
+	Version    string
+	Outcome    string
+	ErrType    string
+	Message    string
+	Detail     string
+	// Timestamp is a unix timestamp in nanoseconds, so events of the same second keep their order
+	Timestamp int64
+}
+
+func toAuditEventDAO(event domain.AuditEvent) AuditEventDAO {
+	return AuditEventDAO{
+		Id:         event.Id,
+		Actor:      event.Actor,
+		Action:     string(event.Action),
+		ConfigType: event.ConfigType,
+		Org:        string(event.Config.Org),
+		Namespace:  event.Config.Namespace,
+		Name:       event.Config.Name,
+		Version:    event.Config.Version,
+		Outcome:    string(event.Outcome),
+		ErrType:    event.ErrType,
+		Message:    event.Message,
+		Detail:     event.Detail,
+		Timestamp:  event.Timestamp.UnixNano(),
+	}
+}
+
+func (dao AuditEventDAO) toDomain() domain.AuditEvent {
+	return domain.AuditEvent{
+		Id:         dao.Id,
+		Actor:      dao.Actor,
+		Action:     domain.AuditAction(dao.Action),
+		ConfigType: dao.ConfigType,
+		Config: domain.ConfigRef{
+			Org:       domain.Org(dao.Org),
+			Namespace: dao.Namespace,
+			Name:      dao.Name,
+			Version:   dao.Version,
+		},
+		Outcome:   domain.AuditOutcome(dao.Outcome),
+		ErrType:   dao.ErrType,
+		Message:   dao.Message,
+		Detail:    dao.Detail,
+		Timestamp: time.Unix(0, dao.Timestamp).UTC(),
+	}
+}
+
+// Key orders the events of a namespace by time, the timestamp is zero padded so the key order matches
+func (dao AuditEventDAO) Key() string {
+	return fmt.Sprintf("audit/%s/%s/%s/%s", dao.Org, dao.Namespace, auditTimestampKey(time.Unix(0, dao.Timestamp)), dao.Id)
+}
+
+// KeyByOrg orders the events of an organization by time, the etcd store indexes the events under it
+// so that listings across namespaces are ranges as well
+func (dao AuditEventDAO) KeyByOrg() string {
+	return fmt.Sprintf("audit_by_org/%s/%s/%s/%s", dao.Org, auditTimestampKey(time.Unix(0, dao.Timestamp)), dao.Namespace, dao.Id)
+}
+
+func (dao AuditEventDAO) KeyPrefixByOrgIndex() string {
+	return fmt.Sprintf("audit_by_org/%s/", dao.Org)
+}
+
+func (dao AuditEventDAO) KeyPrefixByOrg() string {
+	return fmt.Sprintf("audit/%s/", dao.Org)
+}
+
+func (dao AuditEventDAO) KeyPrefixByNamespace() string {
+	return fmt.Sprintf("audit/%s/%s/", dao.Org, dao.Namespace)
+}
+
+func (dao AuditEventDAO) Marshal() (string, error) {
+	jsonBytes, err := json.Marshal(dao)
+	return string(jsonBytes), err
+}
+
+func NewAuditEventDAO(marshalled []byte) (AuditEventDAO, error) {
+	dao := &AuditEventDAO{}
+	err := json.Unmarshal(marshalled, dao)
+	if err != nil {
+		return AuditEventDAO{}, err
+	}
+	return *dao, nil
+}
+
+func auditEventKey(event domain.AuditEvent) string {
+	return toAuditEventDAO(event).Key()
+}
+
+func auditTimestampKey(timestamp time.Time) string {
+	return fmt.Sprintf("%020d", timestamp.UnixNano())
+}
+
+func auditKeyPrefix(filter domain.AuditFilter) string {
+	dao := AuditEventDAO{
+		Org:       string(filter.Org),
+		Namespace: filter.Namespace,
+	}
+	if filter.Namespace == "" {
+		return dao.KeyPrefixByOrg()
+	}
+	return dao.KeyPrefixByNamespace()
+}
@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/c12s/kuiper/internal/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+type AuditKVStore struct {
+	kv localKV
+}
+
+func NewAuditInMemStore() domain.AuditStore {
+	return AuditKVStore{
+		kv: newInMemoryKV(),
+	}
+}
+
+func NewAuditBoltStore(db *bolt.DB) (domain.AuditStore, error) {
+	kv, err := newBoltKV(db)
+	if err != nil {
+		return nil, err
+	}
+	return AuditKVStore{
+		kv: kv,
+	}, nil
+}
+
+func (s AuditKVStore) Append(ctx context.Context, event domain.AuditEvent) *domain.Error {
+	dao := toAuditEventDAO(event)
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	created, err := s.kv.create(dao.Key(), []byte(value))
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !created {
+		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("audit event (id=%s) already exists", event.Id))
+	}
+	return nil
+}
+
+func (s AuditKVStore) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEvent, string, *domain.Error) {
+	if err := filter.Validate(); err != nil {
+		return nil, "", err
+	}
+	prefix := auditKeyPrefix(filter)
+	_, values, err := s.kv.getPrefix(prefix)
+	if err != nil {
+		return nil, "", domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+
+	events := make([]domain.AuditEvent, 0, len(values))
+	for _, value := range values {
+		dao, err := NewAuditEventDAO(value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		events = append(events, dao.toDomain())
+	}
+	return domain.ListAuditEvents(events, filter, prefix, auditEventKey)
+}
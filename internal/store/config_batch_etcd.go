@@ -0,0 +1,102 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// etcdMaxTxnOps is the default --max-txn-ops of etcd, a batch takes one put per config and one for the revision index
+const etcdMaxTxnOps = 128
+
+type ConfigBatchEtcdStore struct {
+	client *clientv3.Client
+}
+
+func NewConfigBatchEtcdStore(client *clientv3.Client) domain.ConfigBatchStore {
+	return ConfigBatchEtcdStore{
+		client: client,
+	}
+}
+
+func (s ConfigBatchEtcdStore) PutBatch(ctx context.Context, batch domain.ConfigBatch) ([]domain.BatchItemError, *domain.Error) {
+	if batch.Len() > etcdMaxTxnOps-1 {
+		return nil, domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("batch of %d configs exceeds the limit of %d configs per batch", batch.Len(), etcdMaxTxnOps-1))
+	}
+	items, err := marshalConfigBatch(batch)
+	if err != nil {
+		return nil, err
+	}
+	conditions := make([]clientv3.Cmp, 0, len(items))
+	puts := make([]clientv3.Op, 0, len(items)+1)
+	for _, item := range items {
+		conditions = append(conditions, clientv3.Compare(clientv3.CreateRevision(item.key), "=", 0))
+		puts = append(puts, clientv3.OpPut(item.key, string(item.value)))
+	}
+	puts = append(puts, revisionIndexOp(time.Now()))
+
+	resp, txnErr := s.client.KV.Txn(ctx).If(conditions...).Then(puts...).Commit()
+	if txnErr != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, txnErr.Error())
+	}
+	if resp.Succeeded {
+		return nil, nil
+	}
+
+	// the transaction doesn't tell which condition failed, so the existing keys are looked up
+	existing := make([]string, 0)
+	for _, item := range items {
+		getResp, getErr := s.client.KV.Get(ctx, item.key, clientv3.WithCountOnly())
+		if getErr != nil {
+			return nil, domain.NewError(domain.ErrTypeDb, getErr.Error())
+		}
+		if getResp.Count > 0 {
+			existing = append(existing, item.key)
+		}
+	}
+	return conflictingBatchItems(items, existing), nil
+}
+
+type batchItem struct {
+	key    string
+	value  []byte
+	config domain.Config
+	index  int
+}
+
+func marshalConfigBatch(batch domain.ConfigBatch) ([]batchItem, *domain.Error) {
+	items := make([]batchItem, 0, batch.Len())
+	for i, config := range batch.StandaloneConfigs {
+		dao := toStandaloneConfigDAO(config)
+		value, err := dao.Marshal()
+		if err != nil {
+			return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		items = append(items, batchItem{key: dao.Key(), value: []byte(value), config: config, index: i})
+	}
+	for i, config := range batch.ConfigGroups {
+		dao := toConfigGroupDAO(config)
+		value, err := dao.Marshal()
+		if err != nil {
+			return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		items = append(items, batchItem{key: dao.Key(), value: []byte(value), config: config, index: i})
+	}
+	return items, nil
+}
+
+func conflictingBatchItems(items []batchItem, existing []string) []domain.BatchItemError {
+	conflicts := make([]domain.BatchItemError, 0, len(existing))
+	for _, item := range items {
+		for _, key := range existing {
+			if item.key == key {
+				conflicts = append(conflicts, domain.VersionExistsError(item.config, item.index))
+				break
+			}
+		}
+	}
+	return conflicts
+}
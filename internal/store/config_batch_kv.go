@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+
+	"github.com/c12s/kuiper/internal/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+type ConfigBatchKVStore struct {
+	kv localKV
+}
+
+// NewConfigInMemStores returns config stores that share one in-memory backend, so batches are atomic across them
+func NewConfigInMemStores() (domain.StandaloneConfigStore, domain.ConfigGroupStore, domain.ConfigBatchStore) {
+	kv := newInMemoryKV()
+	return StandaloneConfigKVStore{kv: kv}, ConfigGroupKVStore{kv: kv}, ConfigBatchKVStore{kv: kv}
+}
+
+// NewConfigBoltStores returns config stores that share one bolt backend, so batches are atomic across them
+func NewConfigBoltStores(db *bolt.DB) (domain.StandaloneConfigStore, domain.ConfigGroupStore, domain.ConfigBatchStore, error) {
+	kv, err := newBoltKV(db)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return StandaloneConfigKVStore{kv: kv}, ConfigGroupKVStore{kv: kv}, ConfigBatchKVStore{kv: kv}, nil
+}
+
+func (s ConfigBatchKVStore) PutBatch(ctx context.Context, batch domain.ConfigBatch) ([]domain.BatchItemError, *domain.Error) {
+	items, marshalErr := marshalConfigBatch(batch)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	keys := make([]string, 0, len(items))
+	values := make([][]byte, 0, len(items))
+	for _, item := range items {
+		keys = append(keys, item.key)
+		values = append(values, item.value)
+	}
+
+	existing, err := s.kv.createAll(keys, values)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if len(existing) > 0 {
+		return conflictingBatchItems(items, existing), nil
+	}
+	return nil, nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	"time"
 
 	"github.com/c12s/kuiper/internal/domain"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -21,23 +21,7 @@ func NewConfigGroupEtcdStore(client *clientv3.Client) domain.ConfigGroupStore {
 }
 
 func (s ConfigGroupEtcdStore) Put(ctx context.Context, config *domain.ConfigGroup) *domain.Error {
-	dao := ConfigGroupDAO{
-		Org:       string(config.Org()),
-		Namespace: config.Namespace(),
-		Name:      config.Name(),
-		Version:   config.Version(),
-		CreatedAt: config.CreatedAtUnixSec(),
-	}
-	for _, ps := range config.ParamSets() {
-		psDao := struct {
-			Name     string
-			ParamSet map[string]string
-		}{
-			Name:     ps.Name(),
-			ParamSet: ps.ParamSet(),
-		}
-		dao.ParamsSets = append(dao.ParamsSets, psDao)
-	}
+	dao := toConfigGroupDAO(config)
 
 	key := dao.Key()
 	value, err := dao.Marshal()
@@ -45,7 +29,7 @@ func (s ConfigGroupEtcdStore) Put(ctx context.Context, config *domain.ConfigGrou
 		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
 
-	resp, err := s.client.KV.Txn(ctx).If(clientv3.CreateRevision(key)).Then(clientv3.OpPut(key, value)).Commit()
+	resp, err := s.client.KV.Txn(ctx).If(clientv3.CreateRevision(key)).Then(clientv3.OpPut(key, value), revisionIndexOp(time.Now())).Commit()
 	if !resp.Succeeded {
 		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) already exists", config.Org(), config.Name(), config.Version()))
 	}
@@ -55,16 +39,30 @@ func (s ConfigGroupEtcdStore) Put(ctx context.Context, config *domain.ConfigGrou
 	return nil
 }
 
+func (s ConfigGroupEtcdStore) PutIfLatest(ctx context.Context, config *domain.ConfigGroup, latest string) *domain.Error {
+	dao := toConfigGroupDAO(config)
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	exists := domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) already exists", config.Org(), config.Name(), config.Version()))
+	return putEtcdIfLatest(ctx, s.client, dao.Key(), value, dao.KeyPrefixByName(), decodeConfigGroup, latest, exists)
+}
+
 func (s ConfigGroupEtcdStore) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	return s.GetAt(ctx, org, namespace, name, version, 0)
+}
+
+func (s ConfigGroupEtcdStore) GetAt(ctx context.Context, org domain.Org, namespace, name, version string, revision int64) (*domain.ConfigGroup, *domain.Error) {
 	key := ConfigGroupDAO{
 		Org:       string(org),
 		Namespace: namespace,
 		Name:      name,
 		Version:   version,
 	}.Key()
-	resp, err := s.client.KV.Get(ctx, key)
+	resp, err := s.client.KV.Get(ctx, key, withRevision(revision)...)
 	if err != nil {
-		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+		return nil, etcdReadError(err, revision)
 	}
 
 	if resp.Count == 0 {
@@ -76,70 +74,118 @@ func (s ConfigGroupEtcdStore) Get(ctx context.Context, org domain.Org, namespace
 		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
 
-	paramSets := make([]domain.NamedParamSet, 0, len(dao.ParamsSets))
-	for _, psDao := range dao.ParamsSets {
-		paramSets = append(paramSets, *domain.NewParamSet(psDao.Name, psDao.ParamSet))
-	}
-
-	return domain.InitConfigGroup(domain.Org(dao.Org), dao.Namespace, dao.Name, dao.Version, dao.CreatedAt, paramSets), nil
+	return dao.toDomain(), nil
 }
 
-func (s ConfigGroupEtcdStore) List(ctx context.Context, org domain.Org, namespace string) ([]*domain.ConfigGroup, *domain.Error) {
+func (s ConfigGroupEtcdStore) List(ctx context.Context, org domain.Org, namespace string, opts domain.ListOptions) ([]*domain.ConfigGroup, string, *domain.Error) {
 	key := ConfigGroupDAO{
 		Org:       string(org),
 		Namespace: namespace,
 	}.KeyPrefixAll()
-	resp, err := s.client.KV.Get(ctx, key, clientv3.WithPrefix())
+	return listEtcdConfigs(ctx, s.client, key, opts, decodeConfigGroup, configGroupKey)
+}
+
+func (s ConfigGroupEtcdStore) ListByOrg(ctx context.Context, org domain.Org) ([]*domain.ConfigGroup, *domain.Error) {
+	key := ConfigGroupDAO{
+		Org: string(org),
+	}.KeyPrefixByOrg()
+	configs, _, err := listEtcdConfigs(ctx, s.client, key, domain.ListOptions{}, decodeConfigGroup, configGroupKey)
+	return configs, err
+}
+
+func (s ConfigGroupEtcdStore) ListVersions(ctx context.Context, org domain.Org, namespace, name string) ([]string, *domain.Error) {
+	return s.ListVersionsAt(ctx, org, namespace, name, 0)
+}
+
+func (s ConfigGroupEtcdStore) ListVersionsAt(ctx context.Context, org domain.Org, namespace, name string, revision int64) ([]string, *domain.Error) {
+	key := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+	}.KeyPrefixByName()
+	resp, err := s.client.KV.Get(ctx, key, withRevision(revision, clientv3.WithPrefix())...)
 	if err != nil {
-		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+		return nil, etcdReadError(err, revision)
 	}
 
-	configs := make([]*domain.ConfigGroup, 0, resp.Count)
+	keys := make([]string, 0, resp.Count)
+	values := make([][]byte, 0, resp.Count)
 	for _, kv := range resp.Kvs {
-		dao, err := NewConfigGroupDAO(kv.Value)
-		if err != nil {
-			log.Println(err)
-			continue
-		}
-
-		paramSets := make([]domain.NamedParamSet, 0, len(dao.ParamsSets))
-		for _, psDao := range dao.ParamsSets {
-			paramSets = append(paramSets, *domain.NewParamSet(psDao.Name, psDao.ParamSet))
-		}
-
-		configs = append(configs, domain.InitConfigGroup(domain.Org(dao.Org), dao.Namespace, dao.Name, dao.Version, dao.CreatedAt, paramSets))
+		keys = append(keys, string(kv.Key))
+		values = append(values, kv.Value)
 	}
+	return approvedVersions(key, keys, values, decodeConfigGroup), nil
+}
 
-	return configs, nil
+func (s ConfigGroupEtcdStore) Revision(ctx context.Context, at domain.PointInTime) (int64, *domain.Error) {
+	return etcdRevision(ctx, s.client, at)
 }
 
-func (s ConfigGroupEtcdStore) Delete(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
-	key := ConfigGroupDAO{
+func (s ConfigGroupEtcdStore) Delete(ctx context.Context, org domain.Org, namespace, name, version string, tombstone *domain.Tombstone) (*domain.ConfigGroup, *domain.Error) {
+	dao := ConfigGroupDAO{
 		Org:       string(org),
 		Namespace: namespace,
 		Name:      name,
 		Version:   version,
-	}.Key()
-	resp, err := s.client.KV.Delete(ctx, key, clientv3.WithPrevKV())
+	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) not found", org, name, version))
+	return moveEtcdConfig(ctx, s.client, dao.Key(), dao.TombstoneKey(), tombstone, decodeConfigGroup, encodeConfigGroup, notFound, nil)
+}
+
+func (s ConfigGroupEtcdStore) ListDeleted(ctx context.Context, org domain.Org, namespace string) ([]*domain.ConfigGroup, *domain.Error) {
+	key := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+	}.TombstoneKeyPrefixAll()
+	resp, err := s.client.KV.Get(ctx, key, clientv3.WithPrefix())
 	if err != nil {
 		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
 	}
-
-	if len(resp.PrevKvs) == 0 {
-		return nil, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) not found", org, name, version))
+	values := make([][]byte, 0, resp.Count)
+	for _, kv := range resp.Kvs {
+		values = append(values, kv.Value)
 	}
+	return listDeletedConfigs(values, decodeConfigGroup), nil
+}
 
-	dao, err := NewConfigGroupDAO(resp.PrevKvs[0].Value)
-	if err != nil {
-		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+func (s ConfigGroupEtcdStore) Restore(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	dao := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
 	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("deleted config group (Org: %s, name: %s, version: %s) not found", org, name, version))
+	exists := domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) has been created again", org, name, version))
+	return moveEtcdConfig(ctx, s.client, dao.TombstoneKey(), dao.Key(), nil, decodeConfigGroup, encodeConfigGroup, notFound, exists)
+}
 
-	paramSets := make([]domain.NamedParamSet, 0, len(dao.ParamsSets))
-	for _, psDao := range dao.ParamsSets {
-		paramSets = append(paramSets, *domain.NewParamSet(psDao.Name, psDao.ParamSet))
+func (s ConfigGroupEtcdStore) PurgeDeleted(ctx context.Context, gracePeriod time.Duration, now time.Time) ([]*domain.ConfigGroup, *domain.Error) {
+	return purgeEtcdConfigs(ctx, s.client, ConfigGroupDAO{}.TombstoneKeyPrefix(), gracePeriod, now, decodeConfigGroup)
+}
+
+func (s ConfigGroupEtcdStore) ReviewDraft(ctx context.Context, org domain.Org, namespace, name, version string, review domain.DraftReview, tombstone *domain.Tombstone) (*domain.ConfigGroup, *domain.Error) {
+	dao := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
 	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) not found", org, name, version))
+	return reviewEtcdConfig(ctx, s.client, dao.Key(), dao.TombstoneKey(), decodeConfigGroup, encodeConfigGroup, notFound, review, tombstone)
+}
 
-	return domain.InitConfigGroup(domain.Org(dao.Org), dao.Namespace, dao.Name, dao.Version, dao.CreatedAt, paramSets), nil
+func (s ConfigGroupEtcdStore) Watch(ctx context.Context, org domain.Org, namespace, name string, fromRevision int64) (<-chan domain.ConfigEvent[*domain.ConfigGroup], *domain.Error) {
+	dao := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+	}
+	key := dao.KeyPrefixAll()
+	if name != "" {
+		key = dao.KeyPrefixByName()
+	}
+	return watchEtcdConfigs(ctx, s.client, key, fromRevision, decodeConfigGroup), nil
 }
 
 type ConfigGroupDAO struct {
@@ -149,19 +195,128 @@ type ConfigGroupDAO struct {
 	Version    string
 	CreatedAt  int64
 	ParamsSets []struct {
-		Name     string
-		ParamSet map[string]string
+		Name       string
+		ParamSet   map[string]string
+		ParamTypes map[string]domain.ParamType
+		Secrets    map[string]bool
+		Removals   []string
 	}
+	Labels      map[string]string
+	Annotations map[string]string
+	Base        *domain.ConfigRef
+	Promoted    *domain.Provenance
+	ContentHash string
+	DuplicateOf string
+	Draft       *domain.Draft
+	Deleted     *domain.Tombstone
+}
+
+func toConfigGroupDAO(config *domain.ConfigGroup) ConfigGroupDAO {
+	dao := ConfigGroupDAO{
+		Org:         string(config.Org()),
+		Namespace:   config.Namespace(),
+		Name:        config.Name(),
+		Version:     config.Version(),
+		CreatedAt:   config.CreatedAtUnixSec(),
+		Labels:      config.Labels(),
+		Annotations: config.Annotations(),
+		Base:        config.Base(),
+		Promoted:    config.PromotedFrom(),
+		ContentHash: config.ContentHash(),
+		DuplicateOf: config.DuplicateOf(),
+		Draft:       config.Draft(),
+		Deleted:     config.Deleted(),
+	}
+	for _, ps := range config.ParamSets() {
+		psDao := struct {
+			Name       string
+			ParamSet   map[string]string
+			ParamTypes map[string]domain.ParamType
+			Secrets    map[string]bool
+			Removals   []string
+		}{
+			Name:       ps.Name(),
+			ParamSet:   ps.ParamSet(),
+			ParamTypes: ps.ParamTypes(),
+			Secrets:    ps.Secrets(),
+			Removals:   ps.Removals(),
+		}
+		dao.ParamsSets = append(dao.ParamsSets, psDao)
+	}
+	return dao
+}
+
+func (dao ConfigGroupDAO) toDomain() *domain.ConfigGroup {
+	paramSets := make([]domain.NamedParamSet, 0, len(dao.ParamsSets))
+	for _, psDao := range dao.ParamsSets {
+		paramSet := domain.NewTypedParamSet(psDao.Name, psDao.ParamSet, psDao.ParamTypes)
+		paramSet.SetSecrets(psDao.Secrets)
+		paramSet.SetRemovals(psDao.Removals)
+		paramSets = append(paramSets, *paramSet)
+	}
+	config := domain.InitConfigGroup(domain.Org(dao.Org), dao.Namespace, dao.Name, dao.Version, dao.CreatedAt, paramSets)
+	config.SetLabels(dao.Labels)
+	config.SetAnnotations(dao.Annotations)
+	config.SetBase(dao.Base)
+	config.SetPromotedFrom(dao.Promoted)
+	config.SetContentHash(dao.ContentHash)
+	config.SetDuplicateOf(dao.DuplicateOf)
+	config.SetDraft(dao.Draft)
+	config.SetDeleted(dao.Deleted)
+	return config
+}
+
+func decodeConfigGroup(marshalled []byte) (*domain.ConfigGroup, error) {
+	dao, err := NewConfigGroupDAO(marshalled)
+	if err != nil {
+		return nil, err
+	}
+	return dao.toDomain(), nil
+}
+
+func encodeConfigGroup(config *domain.ConfigGroup) (string, error) {
+	return toConfigGroupDAO(config).Marshal()
+}
+
+func configGroupKey(config *domain.ConfigGroup) string {
+	return ConfigGroupDAO{
+		Org:       string(config.Org()),
+		Namespace: config.Namespace(),
+		Name:      config.Name(),
+		Version:   config.Version(),
+	}.Key()
 }
 
 func (dao ConfigGroupDAO) Key() string {
 	return fmt.Sprintf("groups/%s/%s/%s/%s", dao.Org, dao.Namespace, dao.Name, dao.Version)
 }
 
+// TombstoneKey is the key of the version once it is deleted
+func (dao ConfigGroupDAO) TombstoneKey() string {
+	return fmt.Sprintf("tombstones/groups/%s/%s/%s/%s", dao.Org, dao.Namespace, dao.Name, dao.Version)
+}
+
+func (dao ConfigGroupDAO) TombstoneKeyPrefixAll() string {
+	return fmt.Sprintf("tombstones/groups/%s/%s/", dao.Org, dao.Namespace)
+}
+
+// TombstoneKeyPrefix covers the deleted versions of all organizations
+func (dao ConfigGroupDAO) TombstoneKeyPrefix() string {
+	return "tombstones/groups/"
+}
+
+func (dao ConfigGroupDAO) KeyPrefixByOrg() string {
+	return fmt.Sprintf("groups/%s/", dao.Org)
+}
+
 func (dao ConfigGroupDAO) KeyPrefixAll() string {
 	return fmt.Sprintf("groups/%s/%s/", dao.Org, dao.Namespace)
 }
 
+func (dao ConfigGroupDAO) KeyPrefixByName() string {
+	return fmt.Sprintf("groups/%s/%s/%s/", dao.Org, dao.Namespace, dao.Name)
+}
+
 func (dao ConfigGroupDAO) Marshal() (string, error) {
 	jsonBytes, err := json.Marshal(dao)
 	return string(jsonBytes), err
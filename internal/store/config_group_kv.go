@@ -0,0 +1,198 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+type ConfigGroupKVStore struct {
+	kv localKV
+}
+
+func NewConfigGroupInMemStore() domain.ConfigGroupStore {
+	return ConfigGroupKVStore{
+		kv: newInMemoryKV(),
+	}
+}
+
+func NewConfigGroupBoltStore(db *bolt.DB) (domain.ConfigGroupStore, error) {
+	kv, err := newBoltKV(db)
+	if err != nil {
+		return nil, err
+	}
+	return ConfigGroupKVStore{
+		kv: kv,
+	}, nil
+}
+
+func (s ConfigGroupKVStore) Put(ctx context.Context, config *domain.ConfigGroup) *domain.Error {
+	dao := toConfigGroupDAO(config)
+
+	key := dao.Key()
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+
+	created, err := s.kv.create(key, []byte(value))
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !created {
+		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) already exists", config.Org(), config.Name(), config.Version()))
+	}
+	return nil
+}
+
+func (s ConfigGroupKVStore) PutIfLatest(ctx context.Context, config *domain.ConfigGroup, latest string) *domain.Error {
+	dao := toConfigGroupDAO(config)
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	exists := domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) already exists", config.Org(), config.Name(), config.Version()))
+	return putLocalIfLatest(s.kv, dao.Key(), []byte(value), dao.KeyPrefixByName(), decodeConfigGroup, latest, exists)
+}
+
+func (s ConfigGroupKVStore) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	key := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}.Key()
+	value, ok, err := s.kv.get(key)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !ok {
+		return nil, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) not found", org, name, version))
+	}
+
+	dao, err := NewConfigGroupDAO(value)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+
+	return dao.toDomain(), nil
+}
+
+func (s ConfigGroupKVStore) GetAt(ctx context.Context, org domain.Org, namespace, name, version string, revision int64) (*domain.ConfigGroup, *domain.Error) {
+	if err := localRevision(revision); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, org, namespace, name, version)
+}
+
+func (s ConfigGroupKVStore) List(ctx context.Context, org domain.Org, namespace string, opts domain.ListOptions) ([]*domain.ConfigGroup, string, *domain.Error) {
+	key := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+	}.KeyPrefixAll()
+	return listLocalConfigs(s.kv, key, opts, decodeConfigGroup, configGroupKey)
+}
+
+func (s ConfigGroupKVStore) ListByOrg(ctx context.Context, org domain.Org) ([]*domain.ConfigGroup, *domain.Error) {
+	key := ConfigGroupDAO{
+		Org: string(org),
+	}.KeyPrefixByOrg()
+	configs, _, err := listLocalConfigs(s.kv, key, domain.ListOptions{}, decodeConfigGroup, configGroupKey)
+	return configs, err
+}
+
+func (s ConfigGroupKVStore) ListVersions(ctx context.Context, org domain.Org, namespace, name string) ([]string, *domain.Error) {
+	key := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+	}.KeyPrefixByName()
+	keys, values, err := s.kv.getPrefix(key)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return approvedVersions(key, keys, values, decodeConfigGroup), nil
+}
+
+func (s ConfigGroupKVStore) ListVersionsAt(ctx context.Context, org domain.Org, namespace, name string, revision int64) ([]string, *domain.Error) {
+	if err := localRevision(revision); err != nil {
+		return nil, err
+	}
+	return s.ListVersions(ctx, org, namespace, name)
+}
+
+func (s ConfigGroupKVStore) Revision(ctx context.Context, at domain.PointInTime) (int64, *domain.Error) {
+	if err := at.Validate(); err != nil {
+		return 0, err
+	}
+	if !at.IsZero() {
+		return 0, errPointInTimeUnsupported
+	}
+	return 0, nil
+}
+
+func (s ConfigGroupKVStore) Delete(ctx context.Context, org domain.Org, namespace, name, version string, tombstone *domain.Tombstone) (*domain.ConfigGroup, *domain.Error) {
+	dao := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) not found", org, name, version))
+	return moveLocalConfig(s.kv, dao.Key(), dao.TombstoneKey(), tombstone, decodeConfigGroup, encodeConfigGroup, notFound, nil)
+}
+
+func (s ConfigGroupKVStore) ListDeleted(ctx context.Context, org domain.Org, namespace string) ([]*domain.ConfigGroup, *domain.Error) {
+	key := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+	}.TombstoneKeyPrefixAll()
+	_, values, err := s.kv.getPrefix(key)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return listDeletedConfigs(values, decodeConfigGroup), nil
+}
+
+func (s ConfigGroupKVStore) Restore(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.ConfigGroup, *domain.Error) {
+	dao := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("deleted config group (Org: %s, name: %s, version: %s) not found", org, name, version))
+	exists := domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) has been created again", org, name, version))
+	return moveLocalConfig(s.kv, dao.TombstoneKey(), dao.Key(), nil, decodeConfigGroup, encodeConfigGroup, notFound, exists)
+}
+
+func (s ConfigGroupKVStore) PurgeDeleted(ctx context.Context, gracePeriod time.Duration, now time.Time) ([]*domain.ConfigGroup, *domain.Error) {
+	return purgeLocalConfigs(s.kv, ConfigGroupDAO{}.TombstoneKeyPrefix(), gracePeriod, now, decodeConfigGroup)
+}
+
+func (s ConfigGroupKVStore) ReviewDraft(ctx context.Context, org domain.Org, namespace, name, version string, review domain.DraftReview, tombstone *domain.Tombstone) (*domain.ConfigGroup, *domain.Error) {
+	dao := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("config group (Org: %s, name: %s, version: %s) not found", org, name, version))
+	return reviewLocalConfig(s.kv, dao.Key(), dao.TombstoneKey(), decodeConfigGroup, encodeConfigGroup, notFound, review, tombstone)
+}
+
+func (s ConfigGroupKVStore) Watch(ctx context.Context, org domain.Org, namespace, name string, fromRevision int64) (<-chan domain.ConfigEvent[*domain.ConfigGroup], *domain.Error) {
+	dao := ConfigGroupDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+	}
+	key := dao.KeyPrefixAll()
+	if name != "" {
+		key = dao.KeyPrefixByName()
+	}
+	return watchLocalConfigs(ctx, s.kv, key, fromRevision, decodeConfigGroup)
+}
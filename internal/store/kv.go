@@ -0,0 +1,35 @@
+package store
+
+import (
+	"context"
+	"errors"
+)
+
+var errKeyExists = errors.New("key already exists")
+
+// localKV is a key-value backend for single instance deployments
+// that keeps the etcd key layout, so the same DAOs and keys can be used
+type localKV interface {
+	// create stores the value only if the key doesn't exist yet
+	create(key string, value []byte) (bool, error)
+	// createAll stores all values atomically only if none of the keys exist yet,
+	// otherwise nothing is stored and the existing keys are returned
+	createAll(keys []string, values [][]byte) ([]string, error)
+	// createChecked stores the value only if the key doesn't exist yet and check accepts the keys and values under the prefix,
+	// the check runs on the same state the value is stored in
+	createChecked(key string, value []byte, prefix string, check func(keys []string, values [][]byte) error) (bool, error)
+	put(key string, value []byte) error
+	// update atomically replaces the value of an existing key with the result of fn,
+	// a nil result deletes the key, it reports false if the key doesn't exist
+	update(key string, fn func(value []byte) ([]byte, error)) (bool, error)
+	// move atomically deletes the key and stores the result of fn under another key,
+	// it reports false if the key doesn't exist and fails with errKeyExists if the other key exists and can't be overwritten
+	move(from, to string, overwrite bool, fn func(value []byte) ([]byte, error)) (bool, error)
+	get(key string) ([]byte, bool, error)
+	// getPrefix returns keys and values sorted by key, the same order etcd uses for range requests
+	getPrefix(prefix string) ([]string, [][]byte, error)
+	delete(key string) ([]byte, bool, error)
+	// watch streams changes of keys under the prefix starting from the revision (inclusive),
+	// the channel is closed when the context is done or the watcher falls behind
+	watch(ctx context.Context, prefix string, fromRevision int64) (<-chan kvEvent, error)
+}
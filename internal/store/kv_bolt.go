@@ -0,0 +1,258 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var boltBucket = []byte("kuiper")
+
+type boltKV struct {
+	db *bolt.DB
+	// writes are serialized so watch events are published in commit order
+	mu  sync.Mutex
+	hub *kvWatchHub
+}
+
+func newBoltKV(db *bolt.DB) (localKV, error) {
+	err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &boltKV{
+		db:  db,
+		hub: newKVWatchHub(),
+	}, nil
+}
+
+func (kv *boltKV) create(key string, value []byte) (bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	created := false
+	err := kv.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		if bucket.Get([]byte(key)) != nil {
+			return nil
+		}
+		created = true
+		return bucket.Put([]byte(key), value)
+	})
+	if err != nil {
+		return false, err
+	}
+	if created {
+		kv.hub.publish(kvEventCreate, key, value)
+	}
+	return created, nil
+}
+
+func (kv *boltKV) createChecked(key string, value []byte, prefix string, check func(keys []string, values [][]byte) error) (bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	created := false
+	err := kv.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		if bucket.Get([]byte(key)) != nil {
+			return nil
+		}
+		keys := make([]string, 0)
+		values := make([][]byte, 0)
+		cursor := bucket.Cursor()
+		for k, v := cursor.Seek([]byte(prefix)); k != nil && bytes.HasPrefix(k, []byte(prefix)); k, v = cursor.Next() {
+			keys = append(keys, string(k))
+			values = append(values, bytes.Clone(v))
+		}
+		if err := check(keys, values); err != nil {
+			return err
+		}
+		created = true
+		return bucket.Put([]byte(key), value)
+	})
+	if err != nil {
+		return true, err
+	}
+	if created {
+		kv.hub.publish(kvEventCreate, key, value)
+	}
+	return created, nil
+}
+
+func (kv *boltKV) createAll(keys []string, values [][]byte) ([]string, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	existing := make([]string, 0)
+	err := kv.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		for _, key := range keys {
+			if bucket.Get([]byte(key)) != nil {
+				existing = append(existing, key)
+			}
+		}
+		if len(existing) > 0 {
+			return nil
+		}
+		for i, key := range keys {
+			if err := bucket.Put([]byte(key), values[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) == 0 {
+		for i, key := range keys {
+			kv.hub.publish(kvEventCreate, key, values[i])
+		}
+	}
+	return existing, nil
+}
+
+func (kv *boltKV) put(key string, value []byte) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	exists := false
+	err := kv.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		exists = bucket.Get([]byte(key)) != nil
+		return bucket.Put([]byte(key), value)
+	})
+	if err != nil {
+		return err
+	}
+	if exists {
+		kv.hub.publish(kvEventPut, key, value)
+	} else {
+		kv.hub.publish(kvEventCreate, key, value)
+	}
+	return nil
+}
+
+func (kv *boltKV) update(key string, fn func(value []byte) ([]byte, error)) (bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	var value, updated []byte
+	err := kv.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		v := bucket.Get([]byte(key))
+		if v == nil {
+			return nil
+		}
+		value = bytes.Clone(v)
+		var err error
+		updated, err = fn(value)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return bucket.Delete([]byte(key))
+		}
+		return bucket.Put([]byte(key), updated)
+	})
+	if value == nil || err != nil {
+		return value != nil, err
+	}
+	if updated == nil {
+		kv.hub.publish(kvEventDelete, key, value)
+	} else {
+		kv.hub.publish(kvEventPut, key, updated)
+	}
+	return true, nil
+}
+
+func (kv *boltKV) move(from, to string, overwrite bool, fn func(value []byte) ([]byte, error)) (bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	var value, moved []byte
+	exists := false
+	err := kv.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		v := bucket.Get([]byte(from))
+		if v == nil {
+			return nil
+		}
+		value = bytes.Clone(v)
+		exists = bucket.Get([]byte(to)) != nil
+		if exists && !overwrite {
+			return errKeyExists
+		}
+		var err error
+		moved, err = fn(value)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Delete([]byte(from)); err != nil {
+			return err
+		}
+		return bucket.Put([]byte(to), moved)
+	})
+	if value == nil || err != nil {
+		return value != nil, err
+	}
+	kv.hub.publish(kvEventDelete, from, value)
+	if exists {
+		kv.hub.publish(kvEventPut, to, moved)
+	} else {
+		kv.hub.publish(kvEventCreate, to, moved)
+	}
+	return true, nil
+}
+
+func (kv *boltKV) get(key string) ([]byte, bool, error) {
+	var value []byte
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		// values are only valid during the transaction
+		if v := tx.Bucket(boltBucket).Get([]byte(key)); v != nil {
+			value = bytes.Clone(v)
+		}
+		return nil
+	})
+	return value, value != nil, err
+}
+
+func (kv *boltKV) getPrefix(prefix string) ([]string, [][]byte, error) {
+	keys := make([]string, 0)
+	values := make([][]byte, 0)
+	err := kv.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(boltBucket).Cursor()
+		for k, v := cursor.Seek([]byte(prefix)); k != nil && bytes.HasPrefix(k, []byte(prefix)); k, v = cursor.Next() {
+			keys = append(keys, string(k))
+			values = append(values, bytes.Clone(v))
+		}
+		return nil
+	})
+	return keys, values, err
+}
+
+func (kv *boltKV) delete(key string) ([]byte, bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	var value []byte
+	err := kv.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		v := bucket.Get([]byte(key))
+		if v == nil {
+			return nil
+		}
+		value = bytes.Clone(v)
+		return bucket.Delete([]byte(key))
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if value != nil {
+		kv.hub.publish(kvEventDelete, key, value)
+	}
+	return value, value != nil, nil
+}
+
+func (kv *boltKV) watch(ctx context.Context, prefix string, fromRevision int64) (<-chan kvEvent, error) {
+	return kv.hub.watch(ctx, prefix, fromRevision)
+}
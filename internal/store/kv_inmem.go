@@ -0,0 +1,175 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type inMemoryKV struct {
+	mu   sync.RWMutex
+	data map[string][]byte
+	hub  *kvWatchHub
+}
+
+func newInMemoryKV() localKV {
+	return &inMemoryKV{
+		data: make(map[string][]byte),
+		hub:  newKVWatchHub(),
+	}
+}
+
+func (kv *inMemoryKV) create(key string, value []byte) (bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if _, ok := kv.data[key]; ok {
+		return false, nil
+	}
+	kv.data[key] = value
+	kv.hub.publish(kvEventCreate, key, value)
+	return true, nil
+}
+
+func (kv *inMemoryKV) createChecked(key string, value []byte, prefix string, check func(keys []string, values [][]byte) error) (bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if _, ok := kv.data[key]; ok {
+		return false, nil
+	}
+	keys := make([]string, 0)
+	for k := range kv.data {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	values := make([][]byte, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, kv.data[k])
+	}
+	if err := check(keys, values); err != nil {
+		return true, err
+	}
+	kv.data[key] = value
+	kv.hub.publish(kvEventCreate, key, value)
+	return true, nil
+}
+
+func (kv *inMemoryKV) createAll(keys []string, values [][]byte) ([]string, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	existing := make([]string, 0)
+	for _, key := range keys {
+		if _, ok := kv.data[key]; ok {
+			existing = append(existing, key)
+		}
+	}
+	if len(existing) > 0 {
+		return existing, nil
+	}
+	for i, key := range keys {
+		kv.data[key] = values[i]
+		kv.hub.publish(kvEventCreate, key, values[i])
+	}
+	return existing, nil
+}
+
+func (kv *inMemoryKV) put(key string, value []byte) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	_, exists := kv.data[key]
+	kv.data[key] = value
+	if exists {
+		kv.hub.publish(kvEventPut, key, value)
+	} else {
+		kv.hub.publish(kvEventCreate, key, value)
+	}
+	return nil
+}
+
+func (kv *inMemoryKV) update(key string, fn func(value []byte) ([]byte, error)) (bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	value, ok := kv.data[key]
+	if !ok {
+		return false, nil
+	}
+	updated, err := fn(value)
+	if err != nil {
+		return true, err
+	}
+	if updated == nil {
+		delete(kv.data, key)
+		kv.hub.publish(kvEventDelete, key, value)
+		return true, nil
+	}
+	kv.data[key] = updated
+	kv.hub.publish(kvEventPut, key, updated)
+	return true, nil
+}
+
+func (kv *inMemoryKV) move(from, to string, overwrite bool, fn func(value []byte) ([]byte, error)) (bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	value, ok := kv.data[from]
+	if !ok {
+		return false, nil
+	}
+	_, exists := kv.data[to]
+	if exists && !overwrite {
+		return true, errKeyExists
+	}
+	moved, err := fn(value)
+	if err != nil {
+		return true, err
+	}
+	delete(kv.data, from)
+	kv.data[to] = moved
+	kv.hub.publish(kvEventDelete, from, value)
+	if exists {
+		kv.hub.publish(kvEventPut, to, moved)
+	} else {
+		kv.hub.publish(kvEventCreate, to, moved)
+	}
+	return true, nil
+}
+
+func (kv *inMemoryKV) get(key string) ([]byte, bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	value, ok := kv.data[key]
+	return value, ok, nil
+}
+
+func (kv *inMemoryKV) getPrefix(prefix string) ([]string, [][]byte, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	keys := make([]string, 0)
+	for key := range kv.data {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, kv.data[key])
+	}
+	return keys, values, nil
+}
+
+func (kv *inMemoryKV) delete(key string) ([]byte, bool, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	value, ok := kv.data[key]
+	if ok {
+		delete(kv.data, key)
+		kv.hub.publish(kvEventDelete, key, value)
+	}
+	return value, ok, nil
+}
+
+func (kv *inMemoryKV) watch(ctx context.Context, prefix string, fromRevision int64) (<-chan kvEvent, error) {
+	return kv.hub.watch(ctx, prefix, fromRevision)
+}
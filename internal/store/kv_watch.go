@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const kvWatchHistorySize = 1024
+
+var errRevisionCompacted = errors.New("revision has been compacted")
+
+type kvEventType int8
+
+const (
+	kvEventCreate kvEventType = iota
+	kvEventPut
+	kvEventDelete
+)
+
+type kvEvent struct {
+	typ      kvEventType
+	key      string
+	value    []byte
+	revision int64
+}
+
+type kvWatcher struct {
+	prefix string
+	events chan kvEvent
+}
+
+// kvWatchHub emulates etcd watches for local key-value backends,
+// the revision only lives as long as the process and the most recent events are kept for resuming
+type kvWatchHub struct {
+	mu       sync.Mutex
+	revision int64
+	history  []kvEvent
+	watchers map[*kvWatcher]struct{}
+}
+
+func newKVWatchHub() *kvWatchHub {
+	return &kvWatchHub{
+		watchers: make(map[*kvWatcher]struct{}),
+	}
+}
+
+// publish must be called while the write lock of the backend is held, so events are ordered by revision
+func (h *kvWatchHub) publish(typ kvEventType, key string, value []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.revision++
+	event := kvEvent{
+		typ:      typ,
+		key:      key,
+		value:    value,
+		revision: h.revision,
+	}
+	h.history = append(h.history, event)
+	if len(h.history) > kvWatchHistorySize {
+		h.history = h.history[len(h.history)-kvWatchHistorySize:]
+	}
+	for watcher := range h.watchers {
+		if !strings.HasPrefix(key, watcher.prefix) {
+			continue
+		}
+		select {
+		case watcher.events <- event:
+		default:
+			// the watcher is too slow, it is closed so the client can resume from its last revision
+			close(watcher.events)
+			delete(h.watchers, watcher)
+		}
+	}
+}
+
+func (h *kvWatchHub) watch(ctx context.Context, prefix string, fromRevision int64) (<-chan kvEvent, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	watcher := &kvWatcher{
+		prefix: prefix,
+		events: make(chan kvEvent, kvWatchHistorySize),
+	}
+	// a revision past the next one was handed out before a restart, the events since then are gone
+	if fromRevision > h.revision+1 {
+		return nil, fmt.Errorf("%w: %d", errRevisionCompacted, fromRevision)
+	}
+	if fromRevision > 0 && fromRevision <= h.revision {
+		if len(h.history) == 0 || h.history[0].revision > fromRevision {
+			return nil, fmt.Errorf("%w: %d", errRevisionCompacted, fromRevision)
+		}
+		for _, event := range h.history {
+			if event.revision >= fromRevision && strings.HasPrefix(event.key, prefix) {
+				watcher.events <- event
+			}
+		}
+	}
+	h.watchers[watcher] = struct{}{}
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.watchers[watcher]; ok {
+			close(watcher.events)
+			delete(h.watchers, watcher)
+		}
+	}()
+	return watcher.events, nil
+}
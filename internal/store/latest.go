@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// errNotLatest aborts a local create, the cause is reported separately
+var errNotLatest = errors.New("not the latest version")
+
+// checkLatest fails unless version is the latest of the versions under the prefix that aren't drafts
+func checkLatest[T draftConfig](prefix string, keys []string, values [][]byte, decode func([]byte) (T, error), version string) *domain.Error {
+	latest, err := domain.ResolveVersion(domain.VersionLatest, approvedVersions(prefix, keys, values, decode))
+	if err != nil {
+		return err
+	}
+	if latest != version {
+		return domain.NewError(domain.ErrTypeFailedPrecondition, fmt.Sprintf("base version %s is not the latest version (%s)", version, latest))
+	}
+	return nil
+}
+
+// putEtcdIfLatest creates the key in a transaction guarded by the mod revision of all versions under the prefix,
+// the check is retried on the latest state if a version was created or reviewed concurrently
+func putEtcdIfLatest[T draftConfig](ctx context.Context, client *clientv3.Client, key, value, prefix string, decode func([]byte) (T, error), latest string, exists *domain.Error) *domain.Error {
+	for {
+		resp, err := client.KV.Get(ctx, prefix, clientv3.WithPrefix())
+		if err != nil {
+			return domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		keys := make([]string, 0, resp.Count)
+		values := make([][]byte, 0, resp.Count)
+		for _, kv := range resp.Kvs {
+			keys = append(keys, string(kv.Key))
+			values = append(values, kv.Value)
+		}
+		if err := checkLatest(prefix, keys, values, decode, latest); err != nil {
+			return err
+		}
+		txnResp, err := client.KV.Txn(ctx).If(
+			clientv3.Compare(clientv3.CreateRevision(key), "=", 0),
+			clientv3.Compare(clientv3.ModRevision(prefix), "<", resp.Header.Revision+1).WithPrefix(),
+		).Then(clientv3.OpPut(key, value), revisionIndexOp(time.Now())).Else(clientv3.OpGet(key, clientv3.WithCountOnly())).Commit()
+		if err != nil {
+			return domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if txnResp.Succeeded {
+			return nil
+		}
+		if txnResp.Responses[0].GetResponseRange().Count > 0 {
+			return exists
+		}
+	}
+}
+
+// putLocalIfLatest creates the key while the backend holds its write lock, so the versions can't change in between
+func putLocalIfLatest[T draftConfig](kv localKV, key string, value []byte, prefix string, decode func([]byte) (T, error), latest string, exists *domain.Error) *domain.Error {
+	var latestErr *domain.Error
+	created, err := kv.createChecked(key, value, prefix, func(keys []string, values [][]byte) error {
+		latestErr = checkLatest(prefix, keys, values, decode, latest)
+		if latestErr != nil {
+			return errNotLatest
+		}
+		return nil
+	})
+	if latestErr != nil {
+		return latestErr
+	}
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !created {
+		return exists
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package store
+
+import (
+	"context"
+	"log"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func listEtcdConfigs[T domain.Config](ctx context.Context, client *clientv3.Client, prefix string, opts domain.ListOptions, decode func([]byte) (T, error), key func(T) string) ([]T, string, *domain.Error) {
+	if err := opts.Validate(); err != nil {
+		return nil, "", err
+	}
+	rangePrefix := prefix + opts.NamePrefix
+	if !opts.KeyOrdered() || opts.PageSize == 0 {
+		resp, err := client.KV.Get(ctx, rangePrefix, withRevision(opts.Revision, clientv3.WithPrefix())...)
+		if err != nil {
+			return nil, "", etcdReadError(err, opts.Revision)
+		}
+		configs := make([]T, 0, resp.Count)
+		for _, kv := range resp.Kvs {
+			config, err := decode(kv.Value)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			configs = append(configs, config)
+		}
+		return domain.ListConfigs(configs, opts, prefix, key)
+	}
+
+	// results are in key order, so only the key range after the page token is scanned
+	start, end := rangePrefix, clientv3.GetPrefixRangeEnd(rangePrefix)
+	order := clientv3.SortAscend
+	if opts.SortDesc {
+		order = clientv3.SortDescend
+	}
+	if opts.PageToken != "" {
+		tokenKey, err := domain.DecodePageToken(opts.PageToken, prefix)
+		if err != nil {
+			return nil, "", err
+		}
+		if opts.SortDesc {
+			end = tokenKey
+		} else {
+			start = tokenKey + "\x00"
+		}
+	}
+
+	page := make([]T, 0, opts.PageSize)
+	for {
+		resp, err := client.KV.Get(ctx, start, withRevision(opts.Revision, clientv3.WithRange(end), clientv3.WithLimit(int64(opts.PageSize)), clientv3.WithSort(clientv3.SortByKey, order))...)
+		if err != nil {
+			return nil, "", etcdReadError(err, opts.Revision)
+		}
+		for i, kv := range resp.Kvs {
+			config, err := decode(kv.Value)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			if !opts.Matches(config) {
+				continue
+			}
+			page = append(page, config)
+			if len(page) == opts.PageSize {
+				if i == len(resp.Kvs)-1 && !resp.More {
+					return page, "", nil
+				}
+				return page, domain.EncodePageToken(string(kv.Key)), nil
+			}
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			return page, "", nil
+		}
+		lastKey := string(resp.Kvs[len(resp.Kvs)-1].Key)
+		if opts.SortDesc {
+			end = lastKey
+		} else {
+			start = lastKey + "\x00"
+		}
+	}
+}
+
+func listLocalConfigs[T domain.Config](kv localKV, prefix string, opts domain.ListOptions, decode func([]byte) (T, error), key func(T) string) ([]T, string, *domain.Error) {
+	if err := opts.Validate(); err != nil {
+		return nil, "", err
+	}
+	if err := localRevision(opts.Revision); err != nil {
+		return nil, "", err
+	}
+	_, values, err := kv.getPrefix(prefix + opts.NamePrefix)
+	if err != nil {
+		return nil, "", domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	configs := make([]T, 0, len(values))
+	for _, value := range values {
+		config, err := decode(value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		configs = append(configs, config)
+	}
+	return domain.ListConfigs(configs, opts, prefix, key)
+}
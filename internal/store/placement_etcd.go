@@ -22,17 +22,7 @@ func NewPlacementEtcdStore(client *clientv3.Client) domain.PlacementStore {
 }
 
 func (s PlacementEtcdStore) Place(ctx context.Context, config domain.Config, req *domain.PlacementTask) *domain.Error {
-	dao := PlacementTaskDAO{
-		Id:         req.Id(),
-		Org:        string(config.Org()),
-		Namespace:  config.Namespace(),
-		Name:       config.Name(),
-		Version:    config.Version(),
-		Node:       string(req.Node()),
-		Status:     req.Status(),
-		AcceptedAt: req.AcceptedAtUnixSec(),
-		ResolvedAt: req.ResolvedAtUnixSec(),
-	}
+	dao := toPlacementTaskDAO(config, req)
 
 	key := dao.Key(config.Type())
 	value, err := dao.Marshal()
@@ -66,7 +56,7 @@ func (s PlacementEtcdStore) ListByConfig(ctx context.Context, org domain.Org, na
 			log.Println(err)
 			continue
 		}
-		reqs = append(reqs, *domain.NewPlacementTask(dao.Id, domain.Node(dao.Node), dao.Status, dao.AcceptedAt, dao.ResolvedAt))
+		reqs = append(reqs, *dao.toDomain())
 	}
 
 	return reqs, nil
@@ -108,6 +98,20 @@ func (s PlacementEtcdStore) UpdateStatus(ctx context.Context, org domain.Org, na
 	return nil
 }
 
+func (s PlacementEtcdStore) DeleteByConfig(ctx context.Context, org domain.Org, namespace, name, version, configType string) *domain.Error {
+	key := PlacementTaskDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}.KeyPrefixByConfig(configType)
+	_, err := s.client.KV.Delete(ctx, key, clientv3.WithPrefix())
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return nil
+}
+
 type PlacementTaskDAO struct {
 	Id         string
 	Org        string
@@ -120,6 +124,24 @@ type PlacementTaskDAO struct {
 	ResolvedAt int64
 }
 
+func toPlacementTaskDAO(config domain.Config, task *domain.PlacementTask) PlacementTaskDAO {
+	return PlacementTaskDAO{
+		Id:         task.Id(),
+		Org:        string(config.Org()),
+		Namespace:  config.Namespace(),
+		Name:       config.Name(),
+		Version:    config.Version(),
+		Node:       string(task.Node()),
+		Status:     task.Status(),
+		AcceptedAt: task.AcceptedAtUnixSec(),
+		ResolvedAt: task.ResolvedAtUnixSec(),
+	}
+}
+
+func (dao PlacementTaskDAO) toDomain() *domain.PlacementTask {
+	return domain.NewPlacementTask(dao.Id, domain.Node(dao.Node), dao.Status, dao.AcceptedAt, dao.ResolvedAt)
+}
+
 func (dao PlacementTaskDAO) Key(configType string) string {
 	return fmt.Sprintf("placements/%s/%s/%s/%s/%s/%s", configType, dao.Org, dao.Namespace, dao.Name, dao.Version, dao.Id)
 }
@@ -0,0 +1,127 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+type PlacementKVStore struct {
+	kv localKV
+}
+
+func NewPlacementInMemStore() domain.PlacementStore {
+	return PlacementKVStore{
+		kv: newInMemoryKV(),
+	}
+}
+
+func NewPlacementBoltStore(db *bolt.DB) (domain.PlacementStore, error) {
+	kv, err := newBoltKV(db)
+	if err != nil {
+		return nil, err
+	}
+	return PlacementKVStore{
+		kv: kv,
+	}, nil
+}
+
+func (s PlacementKVStore) Place(ctx context.Context, config domain.Config, req *domain.PlacementTask) *domain.Error {
+	dao := toPlacementTaskDAO(config, req)
+
+	key := dao.Key(config.Type())
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+
+	err = s.kv.put(key, []byte(value))
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return nil
+}
+
+func (s PlacementKVStore) ListByConfig(ctx context.Context, org domain.Org, namespace, name string, version, configType string) ([]domain.PlacementTask, *domain.Error) {
+	key := PlacementTaskDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}.KeyPrefixByConfig(configType)
+	_, values, err := s.kv.getPrefix(key)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+
+	reqs := make([]domain.PlacementTask, 0, len(values))
+	for _, value := range values {
+		dao, err := NewPlacementTaskDAO(value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		reqs = append(reqs, *dao.toDomain())
+	}
+
+	return reqs, nil
+}
+
+func (s PlacementKVStore) UpdateStatus(ctx context.Context, org domain.Org, namespace, name string, version string, configType string, taskId string, status domain.PlacementTaskStatus) *domain.Error {
+	key := PlacementTaskDAO{
+		Id:        taskId,
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}.Key(configType)
+	value, ok, err := s.kv.get(key)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !ok {
+		return domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("task (id=%s) not found", taskId))
+	}
+
+	dao, err := NewPlacementTaskDAO(value)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+
+	dao.Status = status
+	dao.ResolvedAt = time.Now().Unix()
+
+	updated, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+
+	err = s.kv.put(key, []byte(updated))
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return nil
+}
+
+func (s PlacementKVStore) DeleteByConfig(ctx context.Context, org domain.Org, namespace, name, version, configType string) *domain.Error {
+	key := PlacementTaskDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}.KeyPrefixByConfig(configType)
+	keys, _, err := s.kv.getPrefix(key)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	for _, key := range keys {
+		if _, _, err := s.kv.delete(key); err != nil {
+			return domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type draftConfig interface {
+	domain.Config
+	Draft() *domain.Draft
+	SetDraft(draft *domain.Draft)
+}
+
+type reviewConfig interface {
+	draftConfig
+	SetDeleted(deleted *domain.Tombstone)
+}
+
+// reviewEtcdConfig applies the review in a transaction guarded by the mod revision of the version,
+// the review is retried on the latest state if the version changed concurrently.
+// A discarded version is moved to its tombstone key like a deleted one
+func reviewEtcdConfig[T reviewConfig](ctx context.Context, client *clientv3.Client, key, tombstoneKey string, decode func([]byte) (T, error), encode func(T) (string, error), notFound *domain.Error, review domain.DraftReview, tombstone *domain.Tombstone) (T, *domain.Error) {
+	var zero T
+	for {
+		resp, err := client.KV.Get(ctx, key)
+		if err != nil {
+			return zero, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if resp.Count == 0 {
+			return zero, notFound
+		}
+		config, err := decode(resp.Kvs[0].Value)
+		if err != nil {
+			return zero, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		discard, reviewErr := domain.ApplyDraftReview(config, review)
+		if reviewErr != nil {
+			return zero, reviewErr
+		}
+		if discard {
+			config.SetDeleted(tombstone)
+		}
+		value, err := encode(config)
+		if err != nil {
+			return zero, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		ops := []clientv3.Op{clientv3.OpPut(key, value)}
+		if discard {
+			ops = []clientv3.Op{clientv3.OpDelete(key), clientv3.OpPut(tombstoneKey, value)}
+		}
+		txnResp, err := client.KV.Txn(ctx).If(clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision)).Then(append(ops, revisionIndexOp(time.Now()))...).Commit()
+		if err != nil {
+			return zero, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if txnResp.Succeeded {
+			return config, nil
+		}
+	}
+}
+
+// reviewLocalConfig applies the review while the backend holds its write lock. A discarded version is moved
+// to its tombstone key like a deleted one, the decision is made on a read first and the review is retried
+// if the version changed so that the decision doesn't hold anymore
+func reviewLocalConfig[T reviewConfig](kv localKV, key, tombstoneKey string, decode func([]byte) (T, error), encode func(T) (string, error), notFound *domain.Error, review domain.DraftReview, tombstone *domain.Tombstone) (T, *domain.Error) {
+	for {
+		value, found, err := kv.get(key)
+		if err != nil {
+			var zero T
+			return zero, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if !found {
+			var zero T
+			return zero, notFound
+		}
+		config, err := decode(value)
+		if err != nil {
+			return config, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		discard, reviewErr := domain.ApplyDraftReview(config, review)
+		if reviewErr != nil {
+			return config, reviewErr
+		}
+
+		var reviewed T
+		apply := func(value []byte) ([]byte, error) {
+			config, err := decode(value)
+			if err != nil {
+				reviewErr = domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+				return nil, errReviewFailed
+			}
+			discarded, err2 := domain.ApplyDraftReview(config, review)
+			if err2 != nil {
+				reviewErr = err2
+				return nil, errReviewFailed
+			}
+			if discarded != discard {
+				return nil, errReviewChanged
+			}
+			if discard {
+				config.SetDeleted(tombstone)
+			}
+			reviewed = config
+			encoded, err := encode(config)
+			if err != nil {
+				reviewErr = domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+				return nil, errReviewFailed
+			}
+			return []byte(encoded), nil
+		}
+		if discard {
+			found, err = kv.move(key, tombstoneKey, true, apply)
+		} else {
+			found, err = kv.update(key, apply)
+		}
+		if errors.Is(err, errReviewChanged) {
+			continue
+		}
+		if reviewErr != nil {
+			return reviewed, reviewErr
+		}
+		if err != nil {
+			return reviewed, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if !found {
+			return reviewed, notFound
+		}
+		return reviewed, nil
+	}
+}
+
+// errReviewFailed aborts a local update, the cause is reported separately
+var errReviewFailed = errors.New("review failed")
+
+// errReviewChanged aborts a local update whose review decides differently than on the version read before
+var errReviewChanged = errors.New("review changed")
+
+// approvedVersions returns the versions under the prefix that aren't drafts, a draft is only read by its exact version
+func approvedVersions[T draftConfig](prefix string, keys []string, values [][]byte, decode func([]byte) (T, error)) []string {
+	versions := make([]string, 0, len(keys))
+	for i, key := range keys {
+		config, err := decode(values[i])
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if config.Draft() != nil {
+			continue
+		}
+		versions = append(versions, strings.TrimPrefix(key, prefix))
+	}
+	return versions
+}
@@ -0,0 +1,112 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const revisionIndexPrefix = "revisions/"
+
+// revisionIndexPruneBatch is the number of index keys examined at once while pruning
+const revisionIndexPruneBatch = 1000
+
+// revisionIndexOp records the time of a config write, it is part of the write transaction
+// so the mod revision of the index key is the revision of the write
+func revisionIndexOp(now time.Time) clientv3.Op {
+	return clientv3.OpPut(revisionIndexKey(now.UnixNano()), "")
+}
+
+func revisionIndexKey(unixNano int64) string {
+	return fmt.Sprintf("%s%020d", revisionIndexPrefix, unixNano)
+}
+
+// pruneRevisionIndex removes the index keys whose revisions have been compacted, reads at them fail anyway.
+// The newest of them is kept so that the times it covers are still reported as compacted instead of unrecorded.
+// Keys are ordered by the time of their writes, which is also the order of their revisions
+func pruneRevisionIndex(ctx context.Context, client *clientv3.Client) *domain.Error {
+	start := revisionIndexPrefix
+	end := clientv3.GetPrefixRangeEnd(revisionIndexPrefix)
+	for {
+		resp, err := client.KV.Get(ctx, start, clientv3.WithRange(end), clientv3.WithKeysOnly(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend), clientv3.WithLimit(revisionIndexPruneBatch))
+		if err != nil {
+			return domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		var probeErr error
+		compacted := sort.Search(len(resp.Kvs), func(i int) bool {
+			_, err := client.KV.Get(ctx, revisionIndexPrefix, clientv3.WithRev(resp.Kvs[i].ModRevision), clientv3.WithCountOnly())
+			if err != nil && !errors.Is(err, rpctypes.ErrCompacted) {
+				probeErr = err
+			}
+			return err == nil
+		})
+		if probeErr != nil {
+			return domain.NewError(domain.ErrTypeDb, probeErr.Error())
+		}
+		if compacted < 2 {
+			return nil
+		}
+		kept := string(resp.Kvs[compacted-1].Key)
+		if _, err := client.KV.Delete(ctx, start, clientv3.WithRange(kept)); err != nil {
+			return domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if compacted < len(resp.Kvs) || !resp.More {
+			return nil
+		}
+		start = kept
+	}
+}
+
+// etcdRevision resolves a point in time to the revision of the last config write recorded at or before it
+func etcdRevision(ctx context.Context, client *clientv3.Client, at domain.PointInTime) (int64, *domain.Error) {
+	if err := at.Validate(); err != nil {
+		return 0, err
+	}
+	if at.Time == 0 {
+		return at.Revision, nil
+	}
+	end := revisionIndexKey(time.Unix(at.Time, 0).UnixNano() + 1)
+	resp, err := client.KV.Get(ctx, revisionIndexPrefix, clientv3.WithRange(end), clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend), clientv3.WithLimit(1))
+	if err != nil {
+		return 0, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if len(resp.Kvs) == 0 {
+		return 0, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("no config writes are recorded at or before %s", at))
+	}
+	return resp.Kvs[0].ModRevision, nil
+}
+
+func withRevision(revision int64, opts ...clientv3.OpOption) []clientv3.OpOption {
+	if revision > 0 {
+		opts = append(opts, clientv3.WithRev(revision))
+	}
+	return opts
+}
+
+// etcdReadError maps the errors of reads at a revision, etcd only keeps the revisions since its last compaction
+func etcdReadError(err error, revision int64) *domain.Error {
+	switch {
+	case errors.Is(err, rpctypes.ErrCompacted):
+		return domain.NewError(domain.ErrTypeFailedPrecondition, fmt.Sprintf("revision %d has been compacted", revision))
+	case errors.Is(err, rpctypes.ErrFutureRev):
+		return domain.NewError(domain.ErrTypeSchemaInvalid, fmt.Sprintf("revision %d is newer than the store", revision))
+	default:
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+}
+
+// localRevision rejects point-in-time reads, the local backends only keep the current state
+func localRevision(revision int64) *domain.Error {
+	if revision != 0 {
+		return errPointInTimeUnsupported
+	}
+	return nil
+}
+
+var errPointInTimeUnsupported = domain.NewError(domain.ErrTypeFailedPrecondition, "point-in-time reads are only supported by the etcd store backend")
@@ -4,7 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
+	"time"
 
 	"github.com/c12s/kuiper/internal/domain"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -21,14 +21,7 @@ func NewStandaloneConfigEtcdStore(client *clientv3.Client) domain.StandaloneConf
 }
 
 func (s StandaloneConfigEtcdStore) Put(ctx context.Context, config *domain.StandaloneConfig) *domain.Error {
-	dao := StandaloneConfigDAO{
-		Org:       string(config.Org()),
-		Namespace: config.Namespace(),
-		Name:      config.Name(),
-		Version:   config.Version(),
-		CreatedAt: config.CreatedAtUnixSec(),
-		ParamSet:  config.ParamSet(),
-	}
+	dao := toStandaloneConfigDAO(config)
 
 	key := dao.Key()
 	value, err := dao.Marshal()
@@ -36,7 +29,7 @@ func (s StandaloneConfigEtcdStore) Put(ctx context.Context, config *domain.Stand
 		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
 
-	resp, err := s.client.KV.Txn(ctx).If(clientv3.CreateRevision(key)).Then(clientv3.OpPut(key, value)).Commit()
+	resp, err := s.client.KV.Txn(ctx).If(clientv3.CreateRevision(key)).Then(clientv3.OpPut(key, value), revisionIndexOp(time.Now())).Commit()
 	if !resp.Succeeded {
 		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) already exists", config.Org(), config.Name(), config.Version()))
 	}
@@ -46,16 +39,30 @@ func (s StandaloneConfigEtcdStore) Put(ctx context.Context, config *domain.Stand
 	return nil
 }
 
+func (s StandaloneConfigEtcdStore) PutIfLatest(ctx context.Context, config *domain.StandaloneConfig, latest string) *domain.Error {
+	dao := toStandaloneConfigDAO(config)
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	exists := domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) already exists", config.Org(), config.Name(), config.Version()))
+	return putEtcdIfLatest(ctx, s.client, dao.Key(), value, dao.KeyPrefixByName(), decodeStandaloneConfig, latest, exists)
+}
+
 func (s StandaloneConfigEtcdStore) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
+	return s.GetAt(ctx, org, namespace, name, version, 0)
+}
+
+func (s StandaloneConfigEtcdStore) GetAt(ctx context.Context, org domain.Org, namespace, name, version string, revision int64) (*domain.StandaloneConfig, *domain.Error) {
 	key := StandaloneConfigDAO{
 		Org:       string(org),
 		Namespace: namespace,
 		Name:      name,
 		Version:   version,
 	}.Key()
-	resp, err := s.client.KV.Get(ctx, key)
+	resp, err := s.client.KV.Get(ctx, key, withRevision(revision)...)
 	if err != nil {
-		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+		return nil, etcdReadError(err, revision)
 	}
 
 	if resp.Count == 0 {
@@ -67,76 +74,229 @@ func (s StandaloneConfigEtcdStore) Get(ctx context.Context, org domain.Org, name
 		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
 	}
 
-	paramSet := domain.NewParamSet(dao.Name, dao.ParamSet)
-	return domain.InitStandaloneConfig(domain.Org(dao.Org), dao.Namespace, dao.Version, dao.CreatedAt, *paramSet), nil
+	return dao.toDomain(), nil
 }
 
-func (s StandaloneConfigEtcdStore) List(ctx context.Context, org domain.Org, namespace string) ([]*domain.StandaloneConfig, *domain.Error) {
+func (s StandaloneConfigEtcdStore) List(ctx context.Context, org domain.Org, namespace string, opts domain.ListOptions) ([]*domain.StandaloneConfig, string, *domain.Error) {
 	key := StandaloneConfigDAO{
 		Org:       string(org),
 		Namespace: namespace,
 	}.KeyPrefixAll()
-	resp, err := s.client.KV.Get(ctx, key, clientv3.WithPrefix())
+	return listEtcdConfigs(ctx, s.client, key, opts, decodeStandaloneConfig, standaloneConfigKey)
+}
+
+func (s StandaloneConfigEtcdStore) ListByOrg(ctx context.Context, org domain.Org) ([]*domain.StandaloneConfig, *domain.Error) {
+	key := StandaloneConfigDAO{
+		Org: string(org),
+	}.KeyPrefixByOrg()
+	configs, _, err := listEtcdConfigs(ctx, s.client, key, domain.ListOptions{}, decodeStandaloneConfig, standaloneConfigKey)
+	return configs, err
+}
+
+func (s StandaloneConfigEtcdStore) ListVersions(ctx context.Context, org domain.Org, namespace, name string) ([]string, *domain.Error) {
+	return s.ListVersionsAt(ctx, org, namespace, name, 0)
+}
+
+func (s StandaloneConfigEtcdStore) ListVersionsAt(ctx context.Context, org domain.Org, namespace, name string, revision int64) ([]string, *domain.Error) {
+	key := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+	}.KeyPrefixByName()
+	resp, err := s.client.KV.Get(ctx, key, withRevision(revision, clientv3.WithPrefix())...)
 	if err != nil {
-		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+		return nil, etcdReadError(err, revision)
 	}
 
-	configs := make([]*domain.StandaloneConfig, 0, resp.Count)
+	keys := make([]string, 0, resp.Count)
+	values := make([][]byte, 0, resp.Count)
 	for _, kv := range resp.Kvs {
-		dao, err := NewStandaloneConfigDAO(kv.Value)
-		if err != nil {
-			log.Println(err)
-			continue
-		}
-		paramSet := domain.NewParamSet(dao.Name, dao.ParamSet)
-		configs = append(configs, domain.InitStandaloneConfig(domain.Org(dao.Org), dao.Namespace, dao.Version, dao.CreatedAt, *paramSet))
+		keys = append(keys, string(kv.Key))
+		values = append(values, kv.Value)
 	}
+	return approvedVersions(key, keys, values, decodeStandaloneConfig), nil
+}
 
-	return configs, nil
+func (s StandaloneConfigEtcdStore) Revision(ctx context.Context, at domain.PointInTime) (int64, *domain.Error) {
+	return etcdRevision(ctx, s.client, at)
 }
 
-func (s StandaloneConfigEtcdStore) Delete(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
-	key := StandaloneConfigDAO{
+func (s StandaloneConfigEtcdStore) Delete(ctx context.Context, org domain.Org, namespace, name, version string, tombstone *domain.Tombstone) (*domain.StandaloneConfig, *domain.Error) {
+	dao := StandaloneConfigDAO{
 		Org:       string(org),
 		Namespace: namespace,
 		Name:      name,
 		Version:   version,
-	}.Key()
-	resp, err := s.client.KV.Delete(ctx, key, clientv3.WithPrevKV())
+	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) not found", org, name, version))
+	return moveEtcdConfig(ctx, s.client, dao.Key(), dao.TombstoneKey(), tombstone, decodeStandaloneConfig, encodeStandaloneConfig, notFound, nil)
+}
+
+func (s StandaloneConfigEtcdStore) ListDeleted(ctx context.Context, org domain.Org, namespace string) ([]*domain.StandaloneConfig, *domain.Error) {
+	key := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+	}.TombstoneKeyPrefixAll()
+	resp, err := s.client.KV.Get(ctx, key, clientv3.WithPrefix())
 	if err != nil {
 		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
 	}
+	values := make([][]byte, 0, resp.Count)
+	for _, kv := range resp.Kvs {
+		values = append(values, kv.Value)
+	}
+	return listDeletedConfigs(values, decodeStandaloneConfig), nil
+}
 
-	if len(resp.PrevKvs) == 0 {
-		return nil, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) not found", org, name, version))
+func (s StandaloneConfigEtcdStore) Restore(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
+	dao := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
 	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("deleted standalone config (Org: %s, name: %s, version: %s) not found", org, name, version))
+	exists := domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) has been created again", org, name, version))
+	return moveEtcdConfig(ctx, s.client, dao.TombstoneKey(), dao.Key(), nil, decodeStandaloneConfig, encodeStandaloneConfig, notFound, exists)
+}
 
-	dao, err := NewStandaloneConfigDAO(resp.PrevKvs[0].Value)
-	if err != nil {
-		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+func (s StandaloneConfigEtcdStore) PurgeDeleted(ctx context.Context, gracePeriod time.Duration, now time.Time) ([]*domain.StandaloneConfig, *domain.Error) {
+	return purgeEtcdConfigs(ctx, s.client, StandaloneConfigDAO{}.TombstoneKeyPrefix(), gracePeriod, now, decodeStandaloneConfig)
+}
+
+func (s StandaloneConfigEtcdStore) ReviewDraft(ctx context.Context, org domain.Org, namespace, name, version string, review domain.DraftReview, tombstone *domain.Tombstone) (*domain.StandaloneConfig, *domain.Error) {
+	dao := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
 	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) not found", org, name, version))
+	return reviewEtcdConfig(ctx, s.client, dao.Key(), dao.TombstoneKey(), decodeStandaloneConfig, encodeStandaloneConfig, notFound, review, tombstone)
+}
 
-	paramSet := domain.NewParamSet(dao.Name, dao.ParamSet)
-	return domain.InitStandaloneConfig(domain.Org(dao.Org), dao.Namespace, dao.Version, dao.CreatedAt, *paramSet), nil
+func (s StandaloneConfigEtcdStore) Watch(ctx context.Context, org domain.Org, namespace, name string, fromRevision int64) (<-chan domain.ConfigEvent[*domain.StandaloneConfig], *domain.Error) {
+	dao := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+	}
+	key := dao.KeyPrefixAll()
+	if name != "" {
+		key = dao.KeyPrefixByName()
+	}
+	return watchEtcdConfigs(ctx, s.client, key, fromRevision, decodeStandaloneConfig), nil
 }
 
 type StandaloneConfigDAO struct {
-	Org       string
-	Namespace string
-	Name      string
-	Version   string
-	CreatedAt int64
-	ParamSet  map[string]string
+	Org         string
+	Namespace   string
+	Name        string
+	Version     string
+	CreatedAt   int64
+	ParamSet    map[string]string
+	ParamTypes  map[string]domain.ParamType
+	Secrets     map[string]bool
+	Removals    []string
+	Labels      map[string]string
+	Annotations map[string]string
+	Base        *domain.ConfigRef
+	Promoted    *domain.Provenance
+	ContentHash string
+	DuplicateOf string
+	Draft       *domain.Draft
+	Deleted     *domain.Tombstone
+}
+
+func toStandaloneConfigDAO(config *domain.StandaloneConfig) StandaloneConfigDAO {
+	return StandaloneConfigDAO{
+		Org:         string(config.Org()),
+		Namespace:   config.Namespace(),
+		Name:        config.Name(),
+		Version:     config.Version(),
+		CreatedAt:   config.CreatedAtUnixSec(),
+		ParamSet:    config.ParamSet(),
+		ParamTypes:  config.ParamTypes(),
+		Secrets:     config.NamedParamSet().Secrets(),
+		Removals:    config.NamedParamSet().Removals(),
+		Labels:      config.Labels(),
+		Annotations: config.Annotations(),
+		Base:        config.Base(),
+		Promoted:    config.PromotedFrom(),
+		ContentHash: config.ContentHash(),
+		DuplicateOf: config.DuplicateOf(),
+		Draft:       config.Draft(),
+		Deleted:     config.Deleted(),
+	}
+}
+
+func (dao StandaloneConfigDAO) toDomain() *domain.StandaloneConfig {
+	paramSet := domain.NewTypedParamSet(dao.Name, dao.ParamSet, dao.ParamTypes)
+	paramSet.SetSecrets(dao.Secrets)
+	paramSet.SetRemovals(dao.Removals)
+	config := domain.InitStandaloneConfig(domain.Org(dao.Org), dao.Namespace, dao.Version, dao.CreatedAt, *paramSet)
+	config.SetLabels(dao.Labels)
+	config.SetAnnotations(dao.Annotations)
+	config.SetBase(dao.Base)
+	config.SetPromotedFrom(dao.Promoted)
+	config.SetContentHash(dao.ContentHash)
+	config.SetDuplicateOf(dao.DuplicateOf)
+	config.SetDraft(dao.Draft)
+	config.SetDeleted(dao.Deleted)
+	return config
+}
+
+func decodeStandaloneConfig(marshalled []byte) (*domain.StandaloneConfig, error) {
+	dao, err := NewStandaloneConfigDAO(marshalled)
+	if err != nil {
+		return nil, err
+	}
+	return dao.toDomain(), nil
+}
+
+func encodeStandaloneConfig(config *domain.StandaloneConfig) (string, error) {
+	return toStandaloneConfigDAO(config).Marshal()
+}
+
+func standaloneConfigKey(config *domain.StandaloneConfig) string {
+	return StandaloneConfigDAO{
+		Org:       string(config.Org()),
+		Namespace: config.Namespace(),
+		Name:      config.Name(),
+		Version:   config.Version(),
+	}.Key()
 }
 
 func (dao StandaloneConfigDAO) Key() string {
 	return fmt.Sprintf("standalone/%s/%s/%s/%s", dao.Org, dao.Namespace, dao.Name, dao.Version)
 }
 
+// TombstoneKey is the key of the version once it is deleted
+func (dao StandaloneConfigDAO) TombstoneKey() string {
+	return fmt.Sprintf("tombstones/standalone/%s/%s/%s/%s", dao.Org, dao.Namespace, dao.Name, dao.Version)
+}
+
+func (dao StandaloneConfigDAO) TombstoneKeyPrefixAll() string {
+	return fmt.Sprintf("tombstones/standalone/%s/%s/", dao.Org, dao.Namespace)
+}
+
+// TombstoneKeyPrefix covers the deleted versions of all organizations
+func (dao StandaloneConfigDAO) TombstoneKeyPrefix() string {
+	return "tombstones/standalone/"
+}
+
+func (dao StandaloneConfigDAO) KeyPrefixByOrg() string {
+	return fmt.Sprintf("standalone/%s/", dao.Org)
+}
+
 func (dao StandaloneConfigDAO) KeyPrefixAll() string {
 	return fmt.Sprintf("standalone/%s/%s/", dao.Org, dao.Namespace)
 }
 
+func (dao StandaloneConfigDAO) KeyPrefixByName() string {
+	return fmt.Sprintf("standalone/%s/%s/%s/", dao.Org, dao.Namespace, dao.Name)
+}
+
 func (dao StandaloneConfigDAO) Marshal() (string, error) {
 	jsonBytes, err := json.Marshal(dao)
 	return string(jsonBytes), err
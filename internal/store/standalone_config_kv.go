@@ -0,0 +1,198 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+type StandaloneConfigKVStore struct {
+	kv localKV
+}
+
+func NewStandaloneConfigInMemStore() domain.StandaloneConfigStore {
+	return StandaloneConfigKVStore{
+		kv: newInMemoryKV(),
+	}
+}
+
+func NewStandaloneConfigBoltStore(db *bolt.DB) (domain.StandaloneConfigStore, error) {
+	kv, err := newBoltKV(db)
+	if err != nil {
+		return nil, err
+	}
+	return StandaloneConfigKVStore{
+		kv: kv,
+	}, nil
+}
+
+func (s StandaloneConfigKVStore) Put(ctx context.Context, config *domain.StandaloneConfig) *domain.Error {
+	dao := toStandaloneConfigDAO(config)
+
+	key := dao.Key()
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+
+	created, err := s.kv.create(key, []byte(value))
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !created {
+		return domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) already exists", config.Org(), config.Name(), config.Version()))
+	}
+	return nil
+}
+
+func (s StandaloneConfigKVStore) PutIfLatest(ctx context.Context, config *domain.StandaloneConfig, latest string) *domain.Error {
+	dao := toStandaloneConfigDAO(config)
+	value, err := dao.Marshal()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	exists := domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) already exists", config.Org(), config.Name(), config.Version()))
+	return putLocalIfLatest(s.kv, dao.Key(), []byte(value), dao.KeyPrefixByName(), decodeStandaloneConfig, latest, exists)
+}
+
+func (s StandaloneConfigKVStore) Get(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
+	key := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}.Key()
+	value, ok, err := s.kv.get(key)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !ok {
+		return nil, domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) not found", org, name, version))
+	}
+
+	dao, err := NewStandaloneConfigDAO(value)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+
+	return dao.toDomain(), nil
+}
+
+func (s StandaloneConfigKVStore) GetAt(ctx context.Context, org domain.Org, namespace, name, version string, revision int64) (*domain.StandaloneConfig, *domain.Error) {
+	if err := localRevision(revision); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, org, namespace, name, version)
+}
+
+func (s StandaloneConfigKVStore) List(ctx context.Context, org domain.Org, namespace string, opts domain.ListOptions) ([]*domain.StandaloneConfig, string, *domain.Error) {
+	key := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+	}.KeyPrefixAll()
+	return listLocalConfigs(s.kv, key, opts, decodeStandaloneConfig, standaloneConfigKey)
+}
+
+func (s StandaloneConfigKVStore) ListByOrg(ctx context.Context, org domain.Org) ([]*domain.StandaloneConfig, *domain.Error) {
+	key := StandaloneConfigDAO{
+		Org: string(org),
+	}.KeyPrefixByOrg()
+	configs, _, err := listLocalConfigs(s.kv, key, domain.ListOptions{}, decodeStandaloneConfig, standaloneConfigKey)
+	return configs, err
+}
+
+func (s StandaloneConfigKVStore) ListVersions(ctx context.Context, org domain.Org, namespace, name string) ([]string, *domain.Error) {
+	key := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+	}.KeyPrefixByName()
+	keys, values, err := s.kv.getPrefix(key)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return approvedVersions(key, keys, values, decodeStandaloneConfig), nil
+}
+
+func (s StandaloneConfigKVStore) ListVersionsAt(ctx context.Context, org domain.Org, namespace, name string, revision int64) ([]string, *domain.Error) {
+	if err := localRevision(revision); err != nil {
+		return nil, err
+	}
+	return s.ListVersions(ctx, org, namespace, name)
+}
+
+func (s StandaloneConfigKVStore) Revision(ctx context.Context, at domain.PointInTime) (int64, *domain.Error) {
+	if err := at.Validate(); err != nil {
+		return 0, err
+	}
+	if !at.IsZero() {
+		return 0, errPointInTimeUnsupported
+	}
+	return 0, nil
+}
+
+func (s StandaloneConfigKVStore) Delete(ctx context.Context, org domain.Org, namespace, name, version string, tombstone *domain.Tombstone) (*domain.StandaloneConfig, *domain.Error) {
+	dao := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) not found", org, name, version))
+	return moveLocalConfig(s.kv, dao.Key(), dao.TombstoneKey(), tombstone, decodeStandaloneConfig, encodeStandaloneConfig, notFound, nil)
+}
+
+func (s StandaloneConfigKVStore) ListDeleted(ctx context.Context, org domain.Org, namespace string) ([]*domain.StandaloneConfig, *domain.Error) {
+	key := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+	}.TombstoneKeyPrefixAll()
+	_, values, err := s.kv.getPrefix(key)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return listDeletedConfigs(values, decodeStandaloneConfig), nil
+}
+
+func (s StandaloneConfigKVStore) Restore(ctx context.Context, org domain.Org, namespace, name, version string) (*domain.StandaloneConfig, *domain.Error) {
+	dao := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("deleted standalone config (Org: %s, name: %s, version: %s) not found", org, name, version))
+	exists := domain.NewError(domain.ErrTypeVersionExists, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) has been created again", org, name, version))
+	return moveLocalConfig(s.kv, dao.TombstoneKey(), dao.Key(), nil, decodeStandaloneConfig, encodeStandaloneConfig, notFound, exists)
+}
+
+func (s StandaloneConfigKVStore) PurgeDeleted(ctx context.Context, gracePeriod time.Duration, now time.Time) ([]*domain.StandaloneConfig, *domain.Error) {
+	return purgeLocalConfigs(s.kv, StandaloneConfigDAO{}.TombstoneKeyPrefix(), gracePeriod, now, decodeStandaloneConfig)
+}
+
+func (s StandaloneConfigKVStore) ReviewDraft(ctx context.Context, org domain.Org, namespace, name, version string, review domain.DraftReview, tombstone *domain.Tombstone) (*domain.StandaloneConfig, *domain.Error) {
+	dao := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+		Version:   version,
+	}
+	notFound := domain.NewError(domain.ErrTypeNotFound, fmt.Sprintf("standalone config (Org: %s, name: %s, version: %s) not found", org, name, version))
+	return reviewLocalConfig(s.kv, dao.Key(), dao.TombstoneKey(), decodeStandaloneConfig, encodeStandaloneConfig, notFound, review, tombstone)
+}
+
+func (s StandaloneConfigKVStore) Watch(ctx context.Context, org domain.Org, namespace, name string, fromRevision int64) (<-chan domain.ConfigEvent[*domain.StandaloneConfig], *domain.Error) {
+	dao := StandaloneConfigDAO{
+		Org:       string(org),
+		Namespace: namespace,
+		Name:      name,
+	}
+	key := dao.KeyPrefixAll()
+	if name != "" {
+		key = dao.KeyPrefixByName()
+	}
+	return watchLocalConfigs(ctx, s.kv, key, fromRevision, decodeStandaloneConfig)
+}
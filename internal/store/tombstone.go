@@ -0,0 +1,175 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+type tombstoneConfig interface {
+	domain.Config
+	Deleted() *domain.Tombstone
+	SetDeleted(deleted *domain.Tombstone)
+}
+
+// moveEtcdConfig moves a version between its live key and its tombstone key in a transaction guarded by
+// the mod revision of the source, the version is marked with the tombstone (nil when it is restored)
+func moveEtcdConfig[T tombstoneConfig](ctx context.Context, client *clientv3.Client, from, to string, tombstone *domain.Tombstone, decode func([]byte) (T, error), encode func(T) (string, error), notFound, exists *domain.Error) (T, *domain.Error) {
+	var zero T
+	// a tombstone replaces the previous one, a restored version never replaces a live one
+	overwrite := tombstone != nil
+	for {
+		resp, err := client.KV.Get(ctx, from)
+		if err != nil {
+			return zero, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if resp.Count == 0 {
+			return zero, notFound
+		}
+		config, err := decode(resp.Kvs[0].Value)
+		if err != nil {
+			return zero, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		config.SetDeleted(tombstone)
+		value, err := encode(config)
+		if err != nil {
+			return zero, domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+		}
+		cmps := []clientv3.Cmp{clientv3.Compare(clientv3.ModRevision(from), "=", resp.Kvs[0].ModRevision)}
+		if !overwrite {
+			cmps = append(cmps, clientv3.Compare(clientv3.CreateRevision(to), "=", 0))
+		}
+		txnResp, err := client.KV.Txn(ctx).If(cmps...).Then(clientv3.OpDelete(from), clientv3.OpPut(to, value), revisionIndexOp(time.Now())).Commit()
+		if err != nil {
+			return zero, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if txnResp.Succeeded {
+			return config, nil
+		}
+		if !overwrite {
+			existing, err := client.KV.Get(ctx, to, clientv3.WithCountOnly())
+			if err != nil {
+				return zero, domain.NewError(domain.ErrTypeDb, err.Error())
+			}
+			if existing.Count > 0 {
+				return zero, exists
+			}
+		}
+	}
+}
+
+// moveLocalConfig moves a version between its live key and its tombstone key while the backend holds its write lock
+func moveLocalConfig[T tombstoneConfig](kv localKV, from, to string, tombstone *domain.Tombstone, decode func([]byte) (T, error), encode func(T) (string, error), notFound, exists *domain.Error) (T, *domain.Error) {
+	var moved T
+	var moveErr *domain.Error
+	found, err := kv.move(from, to, tombstone != nil, func(value []byte) ([]byte, error) {
+		config, err := decode(value)
+		if err != nil {
+			moveErr = domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+			return nil, errMoveFailed
+		}
+		config.SetDeleted(tombstone)
+		encoded, err := encode(config)
+		if err != nil {
+			moveErr = domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+			return nil, errMoveFailed
+		}
+		moved = config
+		return []byte(encoded), nil
+	})
+	if moveErr != nil {
+		return moved, moveErr
+	}
+	if errors.Is(err, errKeyExists) {
+		return moved, exists
+	}
+	if err != nil {
+		return moved, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	if !found {
+		return moved, notFound
+	}
+	return moved, nil
+}
+
+// purgeEtcdConfigs removes the expired tombstones under the prefix, a tombstone replaced in the meantime is kept.
+// The revision index is pruned as well, it grows with every write
+func purgeEtcdConfigs[T tombstoneConfig](ctx context.Context, client *clientv3.Client, prefix string, gracePeriod time.Duration, now time.Time, decode func([]byte) (T, error)) ([]T, *domain.Error) {
+	resp, err := client.KV.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	purged := make([]T, 0)
+	for _, kv := range resp.Kvs {
+		config, err := decode(kv.Value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if config.Deleted() == nil || !config.Deleted().Expired(gracePeriod, now) {
+			continue
+		}
+		txnResp, err := client.KV.Txn(ctx).If(clientv3.Compare(clientv3.ModRevision(string(kv.Key)), "=", kv.ModRevision)).Then(clientv3.OpDelete(string(kv.Key))).Commit()
+		if err != nil {
+			return purged, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if txnResp.Succeeded {
+			purged = append(purged, config)
+		}
+	}
+	return purged, pruneRevisionIndex(ctx, client)
+}
+
+// purgeLocalConfigs removes the expired tombstones under the prefix, each one is checked again while it is removed
+func purgeLocalConfigs[T tombstoneConfig](kv localKV, prefix string, gracePeriod time.Duration, now time.Time, decode func([]byte) (T, error)) ([]T, *domain.Error) {
+	keys, values, err := kv.getPrefix(prefix)
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	expired := func(value []byte) (T, bool) {
+		config, err := decode(value)
+		if err != nil {
+			log.Println(err)
+			return config, false
+		}
+		return config, config.Deleted() != nil && config.Deleted().Expired(gracePeriod, now)
+	}
+	purged := make([]T, 0)
+	for i, key := range keys {
+		if _, ok := expired(values[i]); !ok {
+			continue
+		}
+		_, err := kv.update(key, func(value []byte) ([]byte, error) {
+			config, ok := expired(value)
+			if !ok {
+				return value, nil
+			}
+			purged = append(purged, config)
+			return nil, nil
+		})
+		if err != nil {
+			return purged, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+	}
+	return purged, nil
+}
+
+func listDeletedConfigs[T tombstoneConfig](values [][]byte, decode func([]byte) (T, error)) []T {
+	configs := make([]T, 0, len(values))
+	for _, value := range values {
+		config, err := decode(value)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		configs = append(configs, config)
+	}
+	return configs
+}
+
+// errMoveFailed aborts a local move, the cause is reported separately
+var errMoveFailed = errors.New("move failed")
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/c12s/kuiper/internal/domain"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+func watchEtcdConfigs[T domain.Config](ctx context.Context, client *clientv3.Client, prefix string, fromRevision int64, decode func([]byte) (T, error)) <-chan domain.ConfigEvent[T] {
+	opts := []clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithPrevKV()}
+	if fromRevision > 0 {
+		opts = append(opts, clientv3.WithRev(fromRevision))
+	}
+	watchChan := client.Watch(clientv3.WithRequireLeader(ctx), prefix, opts...)
+
+	events := make(chan domain.ConfigEvent[T])
+	go func() {
+		defer close(events)
+		lastRevision := fromRevision - 1
+		for resp := range watchChan {
+			if resp.CompactRevision != 0 {
+				send(ctx, events, domain.ConfigEvent[T]{Err: domain.NewError(domain.ErrTypeFailedPrecondition, fmt.Sprintf("revision %d has been compacted, the oldest available revision is %d", lastRevision+1, resp.CompactRevision))})
+				return
+			}
+			if err := resp.Err(); err != nil {
+				send(ctx, events, domain.ConfigEvent[T]{Err: domain.NewError(domain.ErrTypeDb, err.Error())})
+				return
+			}
+			for _, ev := range resp.Events {
+				lastRevision = ev.Kv.ModRevision
+				event := domain.ConfigEvent[T]{Revision: ev.Kv.ModRevision}
+				var value []byte
+				switch {
+				case ev.IsCreate():
+					event.Type = domain.ConfigEventCreated
+					value = ev.Kv.Value
+				case ev.Type == clientv3.EventTypePut:
+					event.Type = domain.ConfigEventUpdated
+					value = ev.Kv.Value
+				case ev.Type == clientv3.EventTypeDelete && ev.PrevKv != nil:
+					event.Type = domain.ConfigEventDeleted
+					value = ev.PrevKv.Value
+				default:
+					continue
+				}
+				config, err := decode(value)
+				if err != nil {
+					log.Println(err)
+					continue
+				}
+				event.Config = config
+				if !send(ctx, events, event) {
+					return
+				}
+			}
+		}
+		// the watch channel is also closed when etcd cancels the watch, e.g. after losing the leader
+		if ctx.Err() == nil {
+			send(ctx, events, domain.ConfigEvent[T]{Err: domain.NewError(domain.ErrTypeDb, fmt.Sprintf("watch closed by the store, resume from revision %d", lastRevision+1))})
+		}
+	}()
+	return events
+}
+
+func send[T domain.Config](ctx context.Context, events chan<- domain.ConfigEvent[T], event domain.ConfigEvent[T]) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func watchLocalConfigs[T domain.Config](ctx context.Context, kv localKV, prefix string, fromRevision int64, decode func([]byte) (T, error)) (<-chan domain.ConfigEvent[T], *domain.Error) {
+	kvEvents, err := kv.watch(ctx, prefix, fromRevision)
+	if errors.Is(err, errRevisionCompacted) {
+		return nil, domain.NewError(domain.ErrTypeFailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+
+	events := make(chan domain.ConfigEvent[T])
+	go func() {
+		defer close(events)
+		lastRevision := fromRevision
+		for kvEvent := range kvEvents {
+			lastRevision = kvEvent.revision
+			event := domain.ConfigEvent[T]{Revision: kvEvent.revision}
+			switch kvEvent.typ {
+			case kvEventCreate:
+				event.Type = domain.ConfigEventCreated
+			case kvEventPut:
+				event.Type = domain.ConfigEventUpdated
+			case kvEventDelete:
+				event.Type = domain.ConfigEventDeleted
+			default:
+				continue
+			}
+			config, err := decode(kvEvent.value)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			event.Config = config
+			if !send(ctx, events, event) {
+				return
+			}
+		}
+		if ctx.Err() == nil {
+			send(ctx, events, domain.ConfigEvent[T]{Err: domain.NewError(domain.ErrTypeInternal, fmt.Sprintf("watch fell behind, resume from revision %d", lastRevision+1))})
+		}
+	}()
+	return events, nil
+}
@@ -21,19 +21,162 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ListFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NamePrefix    string          `protobuf:"bytes,1,opt,name=namePrefix,proto3" json:"namePrefix,omitempty"`
+	CreatedAfter  int64           `protobuf:"varint,2,opt,name=createdAfter,proto3" json:"createdAfter,omitempty"`
+	CreatedBefore int64           `protobuf:"varint,3,opt,name=createdBefore,proto3" json:"createdBefore,omitempty"`
+	LatestOnly    bool            `protobuf:"varint,4,opt,name=latestOnly,proto3" json:"latestOnly,omitempty"`
+	LabelSelector []*api.Selector `protobuf:"bytes,5,rep,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+}
+
+func (x *ListFilter) Reset() {
+	*x = ListFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilter) ProtoMessage() {}
+
+func (x *ListFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilter.ProtoReflect.Descriptor instead.
+func (*ListFilter) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListFilter) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListFilter) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *ListFilter) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *ListFilter) GetLatestOnly() bool {
+	if x != nil {
+		return x.LatestOnly
+	}
+	return false
+}
+
+func (x *ListFilter) GetLabelSelector() []*api.Selector {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+type ListSort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name, version or createdAt, without it the configs are in key order (name, then version as text)
+	By         string `protobuf:"bytes,1,opt,name=by,proto3" json:"by,omitempty"`
+	Descending bool   `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
+}
+
+func (x *ListSort) Reset() {
+	*x = ListSort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSort) ProtoMessage() {}
+
+func (x *ListSort) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSort.ProtoReflect.Descriptor instead.
+func (*ListSort) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListSort) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+func (x *ListSort) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
 type ListStandaloneConfigReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
-	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Organization string      `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string      `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PageSize     int32       `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken    string      `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	Filter       *ListFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort         *ListSort   `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	ParamFormat  ParamFormat `protobuf:"varint,7,opt,name=paramFormat,proto3,enum=proto.ParamFormat" json:"paramFormat,omitempty"`
+	// reads the state at a store revision or at a unix timestamp (seconds), at most one of them can be set
+	AtRevision int64 `protobuf:"varint,8,opt,name=atRevision,proto3" json:"atRevision,omitempty"`
+	AtTime     int64 `protobuf:"varint,9,opt,name=atTime,proto3" json:"atTime,omitempty"`
 }
 
 func (x *ListStandaloneConfigReq) Reset() {
 	*x = ListStandaloneConfigReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[0]
+		mi := &file_kuiper_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -46,7 +189,7 @@ func (x *ListStandaloneConfigReq) String() string {
 func (*ListStandaloneConfigReq) ProtoMessage() {}
 
 func (x *ListStandaloneConfigReq) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[0]
+	mi := &file_kuiper_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,7 +202,7 @@ func (x *ListStandaloneConfigReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStandaloneConfigReq.ProtoReflect.Descriptor instead.
 func (*ListStandaloneConfigReq) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{0}
+	return file_kuiper_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ListStandaloneConfigReq) GetOrganization() string {
@@ -76,18 +219,68 @@ func (x *ListStandaloneConfigReq) GetNamespace() string {
 	return ""
 }
 
+func (x *ListStandaloneConfigReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStandaloneConfigReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListStandaloneConfigReq) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListStandaloneConfigReq) GetSort() *ListSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListStandaloneConfigReq) GetParamFormat() ParamFormat {
+	if x != nil {
+		return x.ParamFormat
+	}
+	return ParamFormat_Flat
+}
+
+func (x *ListStandaloneConfigReq) GetAtRevision() int64 {
+	if x != nil {
+		return x.AtRevision
+	}
+	return 0
+}
+
+func (x *ListStandaloneConfigReq) GetAtTime() int64 {
+	if x != nil {
+		return x.AtTime
+	}
+	return 0
+}
+
 type ListStandaloneConfigResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Configurations []*StandaloneConfig `protobuf:"bytes,1,rep,name=configurations,proto3" json:"configurations,omitempty"`
+	NextPageToken  string              `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
 func (x *ListStandaloneConfigResp) Reset() {
 	*x = ListStandaloneConfigResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[1]
+		mi := &file_kuiper_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -100,7 +293,7 @@ func (x *ListStandaloneConfigResp) String() string {
 func (*ListStandaloneConfigResp) ProtoMessage() {}
 
 func (x *ListStandaloneConfigResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[1]
+	mi := &file_kuiper_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -113,7 +306,7 @@ func (x *ListStandaloneConfigResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStandaloneConfigResp.ProtoReflect.Descriptor instead.
 func (*ListStandaloneConfigResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{1}
+	return file_kuiper_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListStandaloneConfigResp) GetConfigurations() []*StandaloneConfig {
@@ -123,6 +316,13 @@ func (x *ListStandaloneConfigResp) GetConfigurations() []*StandaloneConfig {
 	return nil
 }
 
+func (x *ListStandaloneConfigResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type DiffReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -130,12 +330,14 @@ type DiffReq struct {
 
 	Reference *ConfigId `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
 	Diff      *ConfigId `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	// list (default), json_patch or unified, json_patch and unified need config.reveal if a secret param changed
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
 }
 
 func (x *DiffReq) Reset() {
 	*x = DiffReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[2]
+		mi := &file_kuiper_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -148,7 +350,7 @@ func (x *DiffReq) String() string {
 func (*DiffReq) ProtoMessage() {}
 
 func (x *DiffReq) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[2]
+	mi := &file_kuiper_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +363,7 @@ func (x *DiffReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffReq.ProtoReflect.Descriptor instead.
 func (*DiffReq) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{2}
+	return file_kuiper_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DiffReq) GetReference() *ConfigId {
@@ -178,18 +380,30 @@ func (x *DiffReq) GetDiff() *ConfigId {
 	return nil
 }
 
+func (x *DiffReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
 type DiffStandaloneConfigResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Diffs []*Diff `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	// RFC 6902 JSON Patch which turns the reference into the compared config, set by the json_patch format,
+	// it applies to the document of the unified diff: the nested param tree, keyed by param set for config groups
+	JsonPatch string `protobuf:"bytes,2,opt,name=jsonPatch,proto3" json:"jsonPatch,omitempty"`
+	// unified diff of the configs rendered as YAML, set by the unified format
+	UnifiedDiff string `protobuf:"bytes,3,opt,name=unifiedDiff,proto3" json:"unifiedDiff,omitempty"`
 }
 
 func (x *DiffStandaloneConfigResp) Reset() {
 	*x = DiffStandaloneConfigResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[3]
+		mi := &file_kuiper_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -202,7 +416,7 @@ func (x *DiffStandaloneConfigResp) String() string {
 func (*DiffStandaloneConfigResp) ProtoMessage() {}
 
 func (x *DiffStandaloneConfigResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[3]
+	mi := &file_kuiper_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -215,7 +429,7 @@ func (x *DiffStandaloneConfigResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffStandaloneConfigResp.ProtoReflect.Descriptor instead.
 func (*DiffStandaloneConfigResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{3}
+	return file_kuiper_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DiffStandaloneConfigResp) GetDiffs() []*Diff {
@@ -225,19 +439,41 @@ func (x *DiffStandaloneConfigResp) GetDiffs() []*Diff {
 	return nil
 }
 
+func (x *DiffStandaloneConfigResp) GetJsonPatch() string {
+	if x != nil {
+		return x.JsonPatch
+	}
+	return ""
+}
+
+func (x *DiffStandaloneConfigResp) GetUnifiedDiff() string {
+	if x != nil {
+		return x.UnifiedDiff
+	}
+	return ""
+}
+
 type ListConfigGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
-	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Organization string      `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string      `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PageSize     int32       `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken    string      `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	Filter       *ListFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort         *ListSort   `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	ParamFormat  ParamFormat `protobuf:"varint,7,opt,name=paramFormat,proto3,enum=proto.ParamFormat" json:"paramFormat,omitempty"`
+	// reads the state at a store revision or at a unix timestamp (seconds), at most one of them can be set
+	AtRevision int64 `protobuf:"varint,8,opt,name=atRevision,proto3" json:"atRevision,omitempty"`
+	AtTime     int64 `protobuf:"varint,9,opt,name=atTime,proto3" json:"atTime,omitempty"`
 }
 
 func (x *ListConfigGroupReq) Reset() {
 	*x = ListConfigGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[4]
+		mi := &file_kuiper_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -250,7 +486,7 @@ func (x *ListConfigGroupReq) String() string {
 func (*ListConfigGroupReq) ProtoMessage() {}
 
 func (x *ListConfigGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[4]
+	mi := &file_kuiper_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +499,7 @@ func (x *ListConfigGroupReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConfigGroupReq.ProtoReflect.Descriptor instead.
 func (*ListConfigGroupReq) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{4}
+	return file_kuiper_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListConfigGroupReq) GetOrganization() string {
@@ -280,18 +516,68 @@ func (x *ListConfigGroupReq) GetNamespace() string {
 	return ""
 }
 
+func (x *ListConfigGroupReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListConfigGroupReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListConfigGroupReq) GetFilter() *ListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListConfigGroupReq) GetSort() *ListSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListConfigGroupReq) GetParamFormat() ParamFormat {
+	if x != nil {
+		return x.ParamFormat
+	}
+	return ParamFormat_Flat
+}
+
+func (x *ListConfigGroupReq) GetAtRevision() int64 {
+	if x != nil {
+		return x.AtRevision
+	}
+	return 0
+}
+
+func (x *ListConfigGroupReq) GetAtTime() int64 {
+	if x != nil {
+		return x.AtTime
+	}
+	return 0
+}
+
 type ListConfigGroupResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Groups []*ConfigGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Groups        []*ConfigGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
 func (x *ListConfigGroupResp) Reset() {
 	*x = ListConfigGroupResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[5]
+		mi := &file_kuiper_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -304,7 +590,7 @@ func (x *ListConfigGroupResp) String() string {
 func (*ListConfigGroupResp) ProtoMessage() {}
 
 func (x *ListConfigGroupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[5]
+	mi := &file_kuiper_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,7 +603,7 @@ func (x *ListConfigGroupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConfigGroupResp.ProtoReflect.Descriptor instead.
 func (*ListConfigGroupResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{5}
+	return file_kuiper_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListConfigGroupResp) GetGroups() []*ConfigGroup {
@@ -327,18 +613,30 @@ func (x *ListConfigGroupResp) GetGroups() []*ConfigGroup {
 	return nil
 }
 
+func (x *ListConfigGroupResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type DiffConfigGroupResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Diffs map[string]*Diffs `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// RFC 6902 JSON Patch which turns the reference into the compared config, set by the json_patch format,
+	// it applies to the document of the unified diff: the nested param tree, keyed by param set for config groups
+	JsonPatch string `protobuf:"bytes,2,opt,name=jsonPatch,proto3" json:"jsonPatch,omitempty"`
+	// unified diff of the configs rendered as YAML, set by the unified format
+	UnifiedDiff string `protobuf:"bytes,3,opt,name=unifiedDiff,proto3" json:"unifiedDiff,omitempty"`
 }
 
 func (x *DiffConfigGroupResp) Reset() {
 	*x = DiffConfigGroupResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[6]
+		mi := &file_kuiper_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -351,7 +649,7 @@ func (x *DiffConfigGroupResp) String() string {
 func (*DiffConfigGroupResp) ProtoMessage() {}
 
 func (x *DiffConfigGroupResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[6]
+	mi := &file_kuiper_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +662,7 @@ func (x *DiffConfigGroupResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffConfigGroupResp.ProtoReflect.Descriptor instead.
 func (*DiffConfigGroupResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{6}
+	return file_kuiper_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DiffConfigGroupResp) GetDiffs() map[string]*Diffs {
@@ -374,6 +672,20 @@ func (x *DiffConfigGroupResp) GetDiffs() map[string]*Diffs {
 	return nil
 }
 
+func (x *DiffConfigGroupResp) GetJsonPatch() string {
+	if x != nil {
+		return x.JsonPatch
+	}
+	return ""
+}
+
+func (x *DiffConfigGroupResp) GetUnifiedDiff() string {
+	if x != nil {
+		return x.UnifiedDiff
+	}
+	return ""
+}
+
 type PlaceReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -386,7 +698,7 @@ type PlaceReq struct {
 func (x *PlaceReq) Reset() {
 	*x = PlaceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[7]
+		mi := &file_kuiper_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -399,7 +711,7 @@ func (x *PlaceReq) String() string {
 func (*PlaceReq) ProtoMessage() {}
 
 func (x *PlaceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[7]
+	mi := &file_kuiper_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +724,7 @@ func (x *PlaceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceReq.ProtoReflect.Descriptor instead.
 func (*PlaceReq) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{7}
+	return file_kuiper_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PlaceReq) GetConfig() *ConfigId {
@@ -440,7 +752,7 @@ type PlaceResp struct {
 func (x *PlaceResp) Reset() {
 	*x = PlaceResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[8]
+		mi := &file_kuiper_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -453,7 +765,7 @@ func (x *PlaceResp) String() string {
 func (*PlaceResp) ProtoMessage() {}
 
 func (x *PlaceResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[8]
+	mi := &file_kuiper_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +778,7 @@ func (x *PlaceResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlaceResp.ProtoReflect.Descriptor instead.
 func (*PlaceResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{8}
+	return file_kuiper_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PlaceResp) GetTasks() []*PlacementTask {
@@ -487,7 +799,7 @@ type ListPlacementTaskResp struct {
 func (x *ListPlacementTaskResp) Reset() {
 	*x = ListPlacementTaskResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[9]
+		mi := &file_kuiper_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -500,319 +812,3180 @@ func (x *ListPlacementTaskResp) String() string {
 func (*ListPlacementTaskResp) ProtoMessage() {}
 
 func (x *ListPlacementTaskResp) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[9]
+	mi := &file_kuiper_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlacementTaskResp.ProtoReflect.Descriptor instead.
+func (*ListPlacementTaskResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPlacementTaskResp) GetTasks() []*PlacementTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type WatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	FromRevision int64  `protobuf:"varint,4,opt,name=fromRevision,proto3" json:"fromRevision,omitempty"`
+}
+
+func (x *WatchReq) Reset() {
+	*x = WatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReq) ProtoMessage() {}
+
+func (x *WatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReq.ProtoReflect.Descriptor instead.
+func (*WatchReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *WatchReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchReq) GetFromRevision() int64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+type StandaloneConfigEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Revision int64             `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Config   *StandaloneConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *StandaloneConfigEvent) Reset() {
+	*x = StandaloneConfigEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandaloneConfigEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandaloneConfigEvent) ProtoMessage() {}
+
+func (x *StandaloneConfigEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandaloneConfigEvent.ProtoReflect.Descriptor instead.
+func (*StandaloneConfigEvent) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StandaloneConfigEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StandaloneConfigEvent) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *StandaloneConfigEvent) GetConfig() *StandaloneConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ConfigGroupEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string       `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Revision int64        `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Config   *ConfigGroup `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ConfigGroupEvent) Reset() {
+	*x = ConfigGroupEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigGroupEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigGroupEvent) ProtoMessage() {}
+
+func (x *ConfigGroupEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigGroupEvent.ProtoReflect.Descriptor instead.
+func (*ConfigGroupEvent) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConfigGroupEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConfigGroupEvent) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ConfigGroupEvent) GetConfig() *ConfigGroup {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ExportNamespaceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Format       string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ExportNamespaceReq) Reset() {
+	*x = ExportNamespaceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportNamespaceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNamespaceReq) ProtoMessage() {}
+
+func (x *ExportNamespaceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNamespaceReq.ProtoReflect.Descriptor instead.
+func (*ExportNamespaceReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportNamespaceReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ExportNamespaceReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ExportNamespaceReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportNamespaceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bundle []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ExportNamespaceResp) Reset() {
+	*x = ExportNamespaceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportNamespaceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNamespaceResp) ProtoMessage() {}
+
+func (x *ExportNamespaceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListPlacementTaskResp.ProtoReflect.Descriptor instead.
-func (*ListPlacementTaskResp) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *ListPlacementTaskResp) GetTasks() []*PlacementTask {
-	if x != nil {
-		return x.Tasks
-	}
-	return nil
-}
-
-type PlaceReq_Strategy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name       string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Query      []*api.Selector `protobuf:"bytes,2,rep,name=query,proto3" json:"query,omitempty"`
-	Percentage int32           `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
-}
-
-func (x *PlaceReq_Strategy) Reset() {
-	*x = PlaceReq_Strategy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kuiper_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *PlaceReq_Strategy) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PlaceReq_Strategy) ProtoMessage() {}
-
-func (x *PlaceReq_Strategy) ProtoReflect() protoreflect.Message {
-	mi := &file_kuiper_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNamespaceResp.ProtoReflect.Descriptor instead.
+func (*ExportNamespaceResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ExportNamespaceResp) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ExportNamespaceResp) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ImportNamespaceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Bundle       []byte `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	Format       string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	ConflictMode string `protobuf:"bytes,5,opt,name=conflictMode,proto3" json:"conflictMode,omitempty"`
+}
+
+func (x *ImportNamespaceReq) Reset() {
+	*x = ImportNamespaceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportNamespaceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNamespaceReq) ProtoMessage() {}
+
+func (x *ImportNamespaceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNamespaceReq.ProtoReflect.Descriptor instead.
+func (*ImportNamespaceReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportNamespaceReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ImportNamespaceReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ImportNamespaceReq) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ImportNamespaceReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportNamespaceReq) GetConflictMode() string {
+	if x != nil {
+		return x.ConflictMode
+	}
+	return ""
+}
+
+type ImportNamespaceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImportedStandaloneConfigs []*ConfigId `protobuf:"bytes,1,rep,name=importedStandaloneConfigs,proto3" json:"importedStandaloneConfigs,omitempty"`
+	ImportedConfigGroups      []*ConfigId `protobuf:"bytes,2,rep,name=importedConfigGroups,proto3" json:"importedConfigGroups,omitempty"`
+	SkippedStandaloneConfigs  []*ConfigId `protobuf:"bytes,3,rep,name=skippedStandaloneConfigs,proto3" json:"skippedStandaloneConfigs,omitempty"`
+	SkippedConfigGroups       []*ConfigId `protobuf:"bytes,4,rep,name=skippedConfigGroups,proto3" json:"skippedConfigGroups,omitempty"`
+}
+
+func (x *ImportNamespaceResp) Reset() {
+	*x = ImportNamespaceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportNamespaceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportNamespaceResp) ProtoMessage() {}
+
+func (x *ImportNamespaceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportNamespaceResp.ProtoReflect.Descriptor instead.
+func (*ImportNamespaceResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ImportNamespaceResp) GetImportedStandaloneConfigs() []*ConfigId {
+	if x != nil {
+		return x.ImportedStandaloneConfigs
+	}
+	return nil
+}
+
+func (x *ImportNamespaceResp) GetImportedConfigGroups() []*ConfigId {
+	if x != nil {
+		return x.ImportedConfigGroups
+	}
+	return nil
+}
+
+func (x *ImportNamespaceResp) GetSkippedStandaloneConfigs() []*ConfigId {
+	if x != nil {
+		return x.SkippedStandaloneConfigs
+	}
+	return nil
+}
+
+func (x *ImportNamespaceResp) GetSkippedConfigGroups() []*ConfigId {
+	if x != nil {
+		return x.SkippedConfigGroups
+	}
+	return nil
+}
+
+type PutBatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StandaloneConfigs []*NewStandaloneConfig `protobuf:"bytes,1,rep,name=standaloneConfigs,proto3" json:"standaloneConfigs,omitempty"`
+	ConfigGroups      []*NewConfigGroup      `protobuf:"bytes,2,rep,name=configGroups,proto3" json:"configGroups,omitempty"`
+}
+
+func (x *PutBatchReq) Reset() {
+	*x = PutBatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutBatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBatchReq) ProtoMessage() {}
+
+func (x *PutBatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBatchReq.ProtoReflect.Descriptor instead.
+func (*PutBatchReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PutBatchReq) GetStandaloneConfigs() []*NewStandaloneConfig {
+	if x != nil {
+		return x.StandaloneConfigs
+	}
+	return nil
+}
+
+func (x *PutBatchReq) GetConfigGroups() []*NewConfigGroup {
+	if x != nil {
+		return x.ConfigGroups
+	}
+	return nil
+}
+
+type BatchItemError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigType string `protobuf:"bytes,1,opt,name=configType,proto3" json:"configType,omitempty"`
+	Index      int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Version    string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Code       string `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	Message    string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BatchItemError) Reset() {
+	*x = BatchItemError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchItemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemError) ProtoMessage() {}
+
+func (x *BatchItemError) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemError.ProtoReflect.Descriptor instead.
+func (*BatchItemError) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BatchItemError) GetConfigType() string {
+	if x != nil {
+		return x.ConfigType
+	}
+	return ""
+}
+
+func (x *BatchItemError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchItemError) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BatchItemError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchItemError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// configs are empty if any item failed, nothing is stored in that case
+type PutBatchResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StandaloneConfigs []*StandaloneConfig `protobuf:"bytes,1,rep,name=standaloneConfigs,proto3" json:"standaloneConfigs,omitempty"`
+	ConfigGroups      []*ConfigGroup      `protobuf:"bytes,2,rep,name=configGroups,proto3" json:"configGroups,omitempty"`
+	Errors            []*BatchItemError   `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *PutBatchResp) Reset() {
+	*x = PutBatchResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutBatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutBatchResp) ProtoMessage() {}
+
+func (x *PutBatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutBatchResp.ProtoReflect.Descriptor instead.
+func (*PutBatchResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PutBatchResp) GetStandaloneConfigs() []*StandaloneConfig {
+	if x != nil {
+		return x.StandaloneConfigs
+	}
+	return nil
+}
+
+func (x *PutBatchResp) GetConfigGroups() []*ConfigGroup {
+	if x != nil {
+		return x.ConfigGroups
+	}
+	return nil
+}
+
+func (x *PutBatchResp) GetErrors() []*BatchItemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type PromoteConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigType         string    `protobuf:"bytes,1,opt,name=configType,proto3" json:"configType,omitempty"`
+	Source             *ConfigId `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	TargetOrganization string    `protobuf:"bytes,3,opt,name=targetOrganization,proto3" json:"targetOrganization,omitempty"`
+	TargetNamespace    string    `protobuf:"bytes,4,opt,name=targetNamespace,proto3" json:"targetNamespace,omitempty"`
+	TargetVersion      string    `protobuf:"bytes,5,opt,name=targetVersion,proto3" json:"targetVersion,omitempty"`
+	Schema             *Schema   `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
+	DuplicateContent   string    `protobuf:"bytes,7,opt,name=duplicateContent,proto3" json:"duplicateContent,omitempty"`
+}
+
+func (x *PromoteConfigReq) Reset() {
+	*x = PromoteConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteConfigReq) ProtoMessage() {}
+
+func (x *PromoteConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteConfigReq.ProtoReflect.Descriptor instead.
+func (*PromoteConfigReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PromoteConfigReq) GetConfigType() string {
+	if x != nil {
+		return x.ConfigType
+	}
+	return ""
+}
+
+func (x *PromoteConfigReq) GetSource() *ConfigId {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *PromoteConfigReq) GetTargetOrganization() string {
+	if x != nil {
+		return x.TargetOrganization
+	}
+	return ""
+}
+
+func (x *PromoteConfigReq) GetTargetNamespace() string {
+	if x != nil {
+		return x.TargetNamespace
+	}
+	return ""
+}
+
+func (x *PromoteConfigReq) GetTargetVersion() string {
+	if x != nil {
+		return x.TargetVersion
+	}
+	return ""
+}
+
+func (x *PromoteConfigReq) GetSchema() *Schema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *PromoteConfigReq) GetDuplicateContent() string {
+	if x != nil {
+		return x.DuplicateContent
+	}
+	return ""
+}
+
+type PromoteConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StandaloneConfig *StandaloneConfig `protobuf:"bytes,1,opt,name=standaloneConfig,proto3" json:"standaloneConfig,omitempty"`
+	ConfigGroup      *ConfigGroup      `protobuf:"bytes,2,opt,name=configGroup,proto3" json:"configGroup,omitempty"`
+}
+
+func (x *PromoteConfigResp) Reset() {
+	*x = PromoteConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromoteConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteConfigResp) ProtoMessage() {}
+
+func (x *PromoteConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteConfigResp.ProtoReflect.Descriptor instead.
+func (*PromoteConfigResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PromoteConfigResp) GetStandaloneConfig() *StandaloneConfig {
+	if x != nil {
+		return x.StandaloneConfig
+	}
+	return nil
+}
+
+func (x *PromoteConfigResp) GetConfigGroup() *ConfigGroup {
+	if x != nil {
+		return x.ConfigGroup
+	}
+	return nil
+}
+
+type ReviewConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigType string    `protobuf:"bytes,1,opt,name=configType,proto3" json:"configType,omitempty"`
+	Config     *ConfigId `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ReviewConfigReq) Reset() {
+	*x = ReviewConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewConfigReq) ProtoMessage() {}
+
+func (x *ReviewConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewConfigReq.ProtoReflect.Descriptor instead.
+func (*ReviewConfigReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReviewConfigReq) GetConfigType() string {
+	if x != nil {
+		return x.ConfigType
+	}
+	return ""
+}
+
+func (x *ReviewConfigReq) GetConfig() *ConfigId {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ReviewConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StandaloneConfig *StandaloneConfig `protobuf:"bytes,1,opt,name=standaloneConfig,proto3" json:"standaloneConfig,omitempty"`
+	ConfigGroup      *ConfigGroup      `protobuf:"bytes,2,opt,name=configGroup,proto3" json:"configGroup,omitempty"`
+}
+
+func (x *ReviewConfigResp) Reset() {
+	*x = ReviewConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewConfigResp) ProtoMessage() {}
+
+func (x *ReviewConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewConfigResp.ProtoReflect.Descriptor instead.
+func (*ReviewConfigResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReviewConfigResp) GetStandaloneConfig() *StandaloneConfig {
+	if x != nil {
+		return x.StandaloneConfig
+	}
+	return nil
+}
+
+func (x *ReviewConfigResp) GetConfigGroup() *ConfigGroup {
+	if x != nil {
+		return x.ConfigGroup
+	}
+	return nil
+}
+
+type ListAuditEventsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Actor        string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action       string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// unix timestamps (seconds), from is inclusive and to exclusive, zero means unbounded
+	From      int64  `protobuf:"varint,5,opt,name=from,proto3" json:"from,omitempty"`
+	To        int64  `protobuf:"varint,6,opt,name=to,proto3" json:"to,omitempty"`
+	PageSize  int32  `protobuf:"varint,7,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,8,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListAuditEventsReq) Reset() {
+	*x = ListAuditEventsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsReq) ProtoMessage() {}
+
+func (x *ListAuditEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsReq.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListAuditEventsReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListAuditEventsReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListAuditEventsReq) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsReq) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsReq) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ListAuditEventsReq) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ListAuditEventsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuditEventsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *ListAuditEventsResp) Reset() {
+	*x = ListAuditEventsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResp) ProtoMessage() {}
+
+func (x *ListAuditEventsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResp.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListAuditEventsResp) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RetentionReportReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (x *RetentionReportReq) Reset() {
+	*x = RetentionReportReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionReportReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionReportReq) ProtoMessage() {}
+
+func (x *RetentionReportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionReportReq.ProtoReflect.Descriptor instead.
+func (*RetentionReportReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RetentionReportReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *RetentionReportReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type RetentionCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigType string    `protobuf:"bytes,1,opt,name=configType,proto3" json:"configType,omitempty"`
+	Config     *ConfigId `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	CreatedAt  string    `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// why an expired version is kept, empty for versions the next collection deletes
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RetentionCandidate) Reset() {
+	*x = RetentionCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionCandidate) ProtoMessage() {}
+
+func (x *RetentionCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionCandidate.ProtoReflect.Descriptor instead.
+func (*RetentionCandidate) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RetentionCandidate) GetConfigType() string {
+	if x != nil {
+		return x.ConfigType
+	}
+	return ""
+}
+
+func (x *RetentionCandidate) GetConfig() *ConfigId {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *RetentionCandidate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RetentionCandidate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RetentionReportResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule    string                `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Expired []*RetentionCandidate `protobuf:"bytes,2,rep,name=expired,proto3" json:"expired,omitempty"`
+	Kept    []*RetentionCandidate `protobuf:"bytes,3,rep,name=kept,proto3" json:"kept,omitempty"`
+}
+
+func (x *RetentionReportResp) Reset() {
+	*x = RetentionReportResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionReportResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionReportResp) ProtoMessage() {}
+
+func (x *RetentionReportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionReportResp.ProtoReflect.Descriptor instead.
+func (*RetentionReportResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RetentionReportResp) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RetentionReportResp) GetExpired() []*RetentionCandidate {
+	if x != nil {
+		return x.Expired
+	}
+	return nil
+}
+
+func (x *RetentionReportResp) GetKept() []*RetentionCandidate {
+	if x != nil {
+		return x.Kept
+	}
+	return nil
+}
+
+type ListDeletedConfigsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string      `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	Namespace    string      `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ParamFormat  ParamFormat `protobuf:"varint,3,opt,name=paramFormat,proto3,enum=proto.ParamFormat" json:"paramFormat,omitempty"`
+}
+
+func (x *ListDeletedConfigsReq) Reset() {
+	*x = ListDeletedConfigsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedConfigsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedConfigsReq) ProtoMessage() {}
+
+func (x *ListDeletedConfigsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedConfigsReq.ProtoReflect.Descriptor instead.
+func (*ListDeletedConfigsReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListDeletedConfigsReq) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *ListDeletedConfigsReq) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListDeletedConfigsReq) GetParamFormat() ParamFormat {
+	if x != nil {
+		return x.ParamFormat
+	}
+	return ParamFormat_Flat
+}
+
+type ListDeletedConfigsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StandaloneConfigs []*StandaloneConfig `protobuf:"bytes,1,rep,name=standaloneConfigs,proto3" json:"standaloneConfigs,omitempty"`
+	ConfigGroups      []*ConfigGroup      `protobuf:"bytes,2,rep,name=configGroups,proto3" json:"configGroups,omitempty"`
+}
+
+func (x *ListDeletedConfigsResp) Reset() {
+	*x = ListDeletedConfigsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedConfigsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedConfigsResp) ProtoMessage() {}
+
+func (x *ListDeletedConfigsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedConfigsResp.ProtoReflect.Descriptor instead.
+func (*ListDeletedConfigsResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListDeletedConfigsResp) GetStandaloneConfigs() []*StandaloneConfig {
+	if x != nil {
+		return x.StandaloneConfigs
+	}
+	return nil
+}
+
+func (x *ListDeletedConfigsResp) GetConfigGroups() []*ConfigGroup {
+	if x != nil {
+		return x.ConfigGroups
+	}
+	return nil
+}
+
+type RestoreConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigType string    `protobuf:"bytes,1,opt,name=configType,proto3" json:"configType,omitempty"`
+	Config     *ConfigId `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *RestoreConfigReq) Reset() {
+	*x = RestoreConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreConfigReq) ProtoMessage() {}
+
+func (x *RestoreConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreConfigReq.ProtoReflect.Descriptor instead.
+func (*RestoreConfigReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestoreConfigReq) GetConfigType() string {
+	if x != nil {
+		return x.ConfigType
+	}
+	return ""
+}
+
+func (x *RestoreConfigReq) GetConfig() *ConfigId {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type RestoreConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StandaloneConfig *StandaloneConfig `protobuf:"bytes,1,opt,name=standaloneConfig,proto3" json:"standaloneConfig,omitempty"`
+	ConfigGroup      *ConfigGroup      `protobuf:"bytes,2,opt,name=configGroup,proto3" json:"configGroup,omitempty"`
+}
+
+func (x *RestoreConfigResp) Reset() {
+	*x = RestoreConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreConfigResp) ProtoMessage() {}
+
+func (x *RestoreConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreConfigResp.ProtoReflect.Descriptor instead.
+func (*RestoreConfigResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RestoreConfigResp) GetStandaloneConfig() *StandaloneConfig {
+	if x != nil {
+		return x.StandaloneConfig
+	}
+	return nil
+}
+
+func (x *RestoreConfigResp) GetConfigGroup() *ConfigGroup {
+	if x != nil {
+		return x.ConfigGroup
+	}
+	return nil
+}
+
+type MergeConfigsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigType string    `protobuf:"bytes,1,opt,name=configType,proto3" json:"configType,omitempty"`
+	Ancestor   *ConfigId `protobuf:"bytes,2,opt,name=ancestor,proto3" json:"ancestor,omitempty"`
+	Ours       *ConfigId `protobuf:"bytes,3,opt,name=ours,proto3" json:"ours,omitempty"`
+	Theirs     *ConfigId `protobuf:"bytes,4,opt,name=theirs,proto3" json:"theirs,omitempty"`
+	// version of the merged config, it is required with autoCommit
+	Version string `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	// autoCommit stores the merged config as a new version of ours if the merge has no conflicts
+	AutoCommit bool `protobuf:"varint,6,opt,name=autoCommit,proto3" json:"autoCommit,omitempty"`
+}
+
+func (x *MergeConfigsReq) Reset() {
+	*x = MergeConfigsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeConfigsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeConfigsReq) ProtoMessage() {}
+
+func (x *MergeConfigsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeConfigsReq.ProtoReflect.Descriptor instead.
+func (*MergeConfigsReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MergeConfigsReq) GetConfigType() string {
+	if x != nil {
+		return x.ConfigType
+	}
+	return ""
+}
+
+func (x *MergeConfigsReq) GetAncestor() *ConfigId {
+	if x != nil {
+		return x.Ancestor
+	}
+	return nil
+}
+
+func (x *MergeConfigsReq) GetOurs() *ConfigId {
+	if x != nil {
+		return x.Ours
+	}
+	return nil
+}
+
+func (x *MergeConfigsReq) GetTheirs() *ConfigId {
+	if x != nil {
+		return x.Theirs
+	}
+	return nil
+}
+
+func (x *MergeConfigsReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *MergeConfigsReq) GetAutoCommit() bool {
+	if x != nil {
+		return x.AutoCommit
+	}
+	return false
+}
+
+type MergeConflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// empty for standalone configs
+	ParamSet string `protobuf:"bytes,1,opt,name=paramSet,proto3" json:"paramSet,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Ours     *Diff  `protobuf:"bytes,3,opt,name=ours,proto3" json:"ours,omitempty"`
+	Theirs   *Diff  `protobuf:"bytes,4,opt,name=theirs,proto3" json:"theirs,omitempty"`
+}
+
+func (x *MergeConflict) Reset() {
+	*x = MergeConflict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeConflict) ProtoMessage() {}
+
+func (x *MergeConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeConflict.ProtoReflect.Descriptor instead.
+func (*MergeConflict) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MergeConflict) GetParamSet() string {
+	if x != nil {
+		return x.ParamSet
+	}
+	return ""
+}
+
+func (x *MergeConflict) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MergeConflict) GetOurs() *Diff {
+	if x != nil {
+		return x.Ours
+	}
+	return nil
+}
+
+func (x *MergeConflict) GetTheirs() *Diff {
+	if x != nil {
+		return x.Theirs
+	}
+	return nil
+}
+
+type MergeConfigsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StandaloneConfig *StandaloneConfig `protobuf:"bytes,1,opt,name=standaloneConfig,proto3" json:"standaloneConfig,omitempty"`
+	ConfigGroup      *ConfigGroup      `protobuf:"bytes,2,opt,name=configGroup,proto3" json:"configGroup,omitempty"`
+	Conflicts        []*MergeConflict  `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Committed        bool              `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"`
+}
+
+func (x *MergeConfigsResp) Reset() {
+	*x = MergeConfigsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeConfigsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeConfigsResp) ProtoMessage() {}
+
+func (x *MergeConfigsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeConfigsResp.ProtoReflect.Descriptor instead.
+func (*MergeConfigsResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MergeConfigsResp) GetStandaloneConfig() *StandaloneConfig {
+	if x != nil {
+		return x.StandaloneConfig
+	}
+	return nil
+}
+
+func (x *MergeConfigsResp) GetConfigGroup() *ConfigGroup {
+	if x != nil {
+		return x.ConfigGroup
+	}
+	return nil
+}
+
+func (x *MergeConfigsResp) GetConflicts() []*MergeConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *MergeConfigsResp) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+// exactly one of standaloneConfig and configGroup is set, nothing is stored
+type DiffProposedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StandaloneConfig *NewStandaloneConfig `protobuf:"bytes,1,opt,name=standaloneConfig,proto3" json:"standaloneConfig,omitempty"`
+	ConfigGroup      *NewConfigGroup      `protobuf:"bytes,2,opt,name=configGroup,proto3" json:"configGroup,omitempty"`
+	// defaults to the latest version of the proposed config, missing fields are taken from the proposed config
+	Reference *ConfigId `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	// list (default), json_patch or unified, json_patch and unified need config.reveal if a secret param changed
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *DiffProposedReq) Reset() {
+	*x = DiffProposedReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffProposedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffProposedReq) ProtoMessage() {}
+
+func (x *DiffProposedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffProposedReq.ProtoReflect.Descriptor instead.
+func (*DiffProposedReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DiffProposedReq) GetStandaloneConfig() *NewStandaloneConfig {
+	if x != nil {
+		return x.StandaloneConfig
+	}
+	return nil
+}
+
+func (x *DiffProposedReq) GetConfigGroup() *NewConfigGroup {
+	if x != nil {
+		return x.ConfigGroup
+	}
+	return nil
+}
+
+func (x *DiffProposedReq) GetReference() *ConfigId {
+	if x != nil {
+		return x.Reference
+	}
+	return nil
+}
+
+func (x *DiffProposedReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type DiffProposedResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StandaloneConfigDiffs []*Diff           `protobuf:"bytes,1,rep,name=standaloneConfigDiffs,proto3" json:"standaloneConfigDiffs,omitempty"`
+	ConfigGroupDiffs      map[string]*Diffs `protobuf:"bytes,2,rep,name=configGroupDiffs,proto3" json:"configGroupDiffs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// RFC 6902 JSON Patch which turns the reference into the proposed config, set by the json_patch format,
+	// it applies to the document of the unified diff: the nested param tree, keyed by param set for config groups
+	JsonPatch string `protobuf:"bytes,3,opt,name=jsonPatch,proto3" json:"jsonPatch,omitempty"`
+	// unified diff of the configs rendered as YAML, set by the unified format
+	UnifiedDiff string `protobuf:"bytes,4,opt,name=unifiedDiff,proto3" json:"unifiedDiff,omitempty"`
+}
+
+func (x *DiffProposedResp) Reset() {
+	*x = DiffProposedResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffProposedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffProposedResp) ProtoMessage() {}
+
+func (x *DiffProposedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffProposedResp.ProtoReflect.Descriptor instead.
+func (*DiffProposedResp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DiffProposedResp) GetStandaloneConfigDiffs() []*Diff {
+	if x != nil {
+		return x.StandaloneConfigDiffs
+	}
+	return nil
+}
+
+func (x *DiffProposedResp) GetConfigGroupDiffs() map[string]*Diffs {
+	if x != nil {
+		return x.ConfigGroupDiffs
+	}
+	return nil
+}
+
+func (x *DiffProposedResp) GetJsonPatch() string {
+	if x != nil {
+		return x.JsonPatch
+	}
+	return ""
+}
+
+func (x *DiffProposedResp) GetUnifiedDiff() string {
+	if x != nil {
+		return x.UnifiedDiff
+	}
+	return ""
+}
+
+type PatchOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// addition, replacement or deletion of a param, param_set_addition or param_set_deletion for config groups
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// param set of the changed param in a config group, or the added or removed param set
+	ParamSet string `protobuf:"bytes,2,opt,name=paramSet,proto3" json:"paramSet,omitempty"`
+	// the changed param, deletions only use its key
+	Param *Param `protobuf:"bytes,3,opt,name=param,proto3" json:"param,omitempty"`
+}
+
+func (x *PatchOp) Reset() {
+	*x = PatchOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchOp) ProtoMessage() {}
+
+func (x *PatchOp) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchOp.ProtoReflect.Descriptor instead.
+func (*PatchOp) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PatchOp) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PatchOp) GetParamSet() string {
+	if x != nil {
+		return x.ParamSet
+	}
+	return ""
+}
+
+func (x *PatchOp) GetParam() *Param {
+	if x != nil {
+		return x.Param
+	}
+	return nil
+}
+
+type PatchConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the ops are applied to the effective config of the base version
+	Base    *ConfigId  `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Version string     `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Ops     []*PatchOp `protobuf:"bytes,3,rep,name=ops,proto3" json:"ops,omitempty"`
+	// fails the patch if the base isn't the latest version of the config anymore
+	RequireLatest    bool   `protobuf:"varint,4,opt,name=requireLatest,proto3" json:"requireLatest,omitempty"`
+	DuplicateContent string `protobuf:"bytes,5,opt,name=duplicateContent,proto3" json:"duplicateContent,omitempty"`
+}
+
+func (x *PatchConfigReq) Reset() {
+	*x = PatchConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchConfigReq) ProtoMessage() {}
+
+func (x *PatchConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchConfigReq.ProtoReflect.Descriptor instead.
+func (*PatchConfigReq) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PatchConfigReq) GetBase() *ConfigId {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *PatchConfigReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PatchConfigReq) GetOps() []*PatchOp {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *PatchConfigReq) GetRequireLatest() bool {
+	if x != nil {
+		return x.RequireLatest
+	}
+	return false
+}
+
+func (x *PatchConfigReq) GetDuplicateContent() string {
+	if x != nil {
+		return x.DuplicateContent
+	}
+	return ""
+}
+
+type PlaceReq_Strategy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Query      []*api.Selector `protobuf:"bytes,2,rep,name=query,proto3" json:"query,omitempty"`
+	Percentage int32           `protobuf:"varint,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *PlaceReq_Strategy) Reset() {
+	*x = PlaceReq_Strategy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kuiper_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceReq_Strategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceReq_Strategy) ProtoMessage() {}
+
+func (x *PlaceReq_Strategy) ProtoReflect() protoreflect.Message {
+	mi := &file_kuiper_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceReq_Strategy.ProtoReflect.Descriptor instead.
+func (*PlaceReq_Strategy) Descriptor() ([]byte, []int) {
+	return file_kuiper_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *PlaceReq_Strategy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlaceReq_Strategy) GetQuery() []*api.Selector {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *PlaceReq_Strategy) GetPercentage() int32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+var File_kuiper_proto protoreflect.FileDescriptor
+
+var file_kuiper_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x6b, 0x75, 0x69, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6b, 0x75, 0x69, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x6d, 0x61, 0x67, 0x6e, 0x65,
+	0x74, 0x61, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcd, 0x01, 0x0a, 0x0a, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61,
+	0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4f, 0x6e, 0x6c, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x35, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x3a, 0x0a, 0x08, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x62, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xd3, 0x02, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x34, 0x0a,
+	0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c,
+	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x75, 0x0a, 0x07, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x12, 0x2d, 0x0a, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x09,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x7d, 0x0a, 0x18, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05,
+	0x64, 0x69, 0x66, 0x66, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44, 0x69,
+	0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x44, 0x69, 0x66, 0x66, 0x22, 0xce, 0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f,
+	0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x61, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x61, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x67, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xda, 0x01, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3b, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64,
+	0x69, 0x66, 0x66, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44, 0x69, 0x66,
+	0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x44, 0x69, 0x66, 0x66, 0x1a, 0x46, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66,
+	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x01, 0x0a,
+	0x08, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x1a, 0x65, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22,
+	0x37, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x05,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x43, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x84, 0x01,
+	0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6e,
+	0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6e,
+	0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x45,
+	0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x22, 0xb9, 0x02, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x19, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x19,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x43, 0x0a, 0x14, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x14, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x4b,
+	0x0a, 0x18, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c,
+	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x64, 0x52, 0x18, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61,
+	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x41, 0x0a, 0x13, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x13, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x92,
+	0x01, 0x0a, 0x0b, 0x50, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x48,
+	0x0a, 0x11, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65,
+	0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x50, 0x75, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x11, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x12, 0x36, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2a, 0x0a, 0x10,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43,
+	0x0a, 0x10, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x10, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x5a, 0x0a, 0x0f, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x10, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xe2, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x66, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x56, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x12, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x8d, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x07,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x2d, 0x0a, 0x04, 0x6b, 0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x04, 0x6b, 0x65, 0x70, 0x74,
+	0x22, 0x8f, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x0b,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a,
+	0x11, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x11, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x5b, 0x0a, 0x10,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x43, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x10, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xe6, 0x01, 0x0a, 0x0f, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b,
+	0x0a, 0x08, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x64, 0x52, 0x08, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x6f,
+	0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x04, 0x6f, 0x75, 0x72, 0x73,
+	0x12, 0x27, 0x0a, 0x06, 0x74, 0x68, 0x65, 0x69, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x64, 0x52, 0x06, 0x74, 0x68, 0x65, 0x69, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x04, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04,
+	0x6f, 0x75, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x06, 0x74, 0x68, 0x65, 0x69, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x06, 0x74, 0x68, 0x65, 0x69, 0x72, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x10, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43,
+	0x0a, 0x10, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x10, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x0f,
+	0x44, 0x69, 0x66, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12,
+	0x46, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x2d, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x49, 0x64, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xc3, 0x02, 0x0a, 0x10, 0x44, 0x69, 0x66, 0x66,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x15,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x44, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x15, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61,
+	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x66, 0x66, 0x73, 0x12,
+	0x59, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69,
+	0x66, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69,
+	0x66, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x66, 0x66, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x73,
+	0x6f, 0x6e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a,
+	0x73, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x44, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75,
+	0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44, 0x69, 0x66, 0x66, 0x1a, 0x51, 0x0a, 0x15, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x66, 0x66, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66,
+	0x66, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5d, 0x0a,
+	0x07, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x22, 0xc3, 0x01, 0x0a,
+	0x0e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x23, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x03, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x52, 0x03, 0x6f, 0x70, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x32, 0xdc, 0x10, 0x0a, 0x06, 0x4b, 0x75, 0x69, 0x70, 0x65, 0x72, 0x12, 0x4c, 0x0a,
+	0x13, 0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c,
+	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x49, 0x64, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x16, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x49, 0x64, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f,
+	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x56, 0x0a,
+	0x23, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x42, 0x79, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61,
+	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x64, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00,
+	0x12, 0x37, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x1e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f,
+	0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x1a,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x11, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4a, 0x0a,
+	0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0f, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x08, 0x50, 0x75, 0x74, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x41, 0x0a, 0x0c, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x44, 0x69, 0x66, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x15, 0x50, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00,
+	0x12, 0x3f, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22,
+	0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kuiper_proto_rawDescOnce sync.Once
+	file_kuiper_proto_rawDescData = file_kuiper_proto_rawDesc
+)
+
+func file_kuiper_proto_rawDescGZIP() []byte {
+	file_kuiper_proto_rawDescOnce.Do(func() {
+		file_kuiper_proto_rawDescData = protoimpl.X.CompressGZIP(file_kuiper_proto_rawDescData)
+	})
+	return file_kuiper_proto_rawDescData
+}
+
+var file_kuiper_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_kuiper_proto_goTypes = []interface{}{
+	(*ListFilter)(nil),               // 0: proto.ListFilter
+	(*ListSort)(nil),                 // 1: proto.ListSort
+	(*ListStandaloneConfigReq)(nil),  // 2: proto.ListStandaloneConfigReq
+	(*ListStandaloneConfigResp)(nil), // 3: proto.ListStandaloneConfigResp
+	(*DiffReq)(nil),                  // 4: proto.DiffReq
+	(*DiffStandaloneConfigResp)(nil), // 5: proto.DiffStandaloneConfigResp
+	(*ListConfigGroupReq)(nil),       // 6: proto.ListConfigGroupReq
+	(*ListConfigGroupResp)(nil),      // 7: proto.ListConfigGroupResp
+	(*DiffConfigGroupResp)(nil),      // 8: proto.DiffConfigGroupResp
+	(*PlaceReq)(nil),                 // 9: proto.PlaceReq
+	(*PlaceResp)(nil),                // 10: proto.PlaceResp
+	(*ListPlacementTaskResp)(nil),    // 11: proto.ListPlacementTaskResp
+	(*WatchReq)(nil),                 // 12: proto.WatchReq
+	(*StandaloneConfigEvent)(nil),    // 13: proto.StandaloneConfigEvent
+	(*ConfigGroupEvent)(nil),         // 14: proto.ConfigGroupEvent
+	(*ExportNamespaceReq)(nil),       // 15: proto.ExportNamespaceReq
+	(*ExportNamespaceResp)(nil),      // 16: proto.ExportNamespaceResp
+	(*ImportNamespaceReq)(nil),       // 17: proto.ImportNamespaceReq
+	(*ImportNamespaceResp)(nil),      // 18: proto.ImportNamespaceResp
+	(*PutBatchReq)(nil),              // 19: proto.PutBatchReq
+	(*BatchItemError)(nil),           // 20: proto.BatchItemError
+	(*PutBatchResp)(nil),             // 21: proto.PutBatchResp
+	(*PromoteConfigReq)(nil),         // 22: proto.PromoteConfigReq
+	(*PromoteConfigResp)(nil),        // 23: proto.PromoteConfigResp
+	(*ReviewConfigReq)(nil),          // 24: proto.ReviewConfigReq
+	(*ReviewConfigResp)(nil),         // 25: proto.ReviewConfigResp
+	(*ListAuditEventsReq)(nil),       // 26: proto.ListAuditEventsReq
+	(*ListAuditEventsResp)(nil),      // 27: proto.ListAuditEventsResp
+	(*RetentionReportReq)(nil),       // 28: proto.RetentionReportReq
+	(*RetentionCandidate)(nil),       // 29: proto.RetentionCandidate
+	(*RetentionReportResp)(nil),      // 30: proto.RetentionReportResp
+	(*ListDeletedConfigsReq)(nil),    // 31: proto.ListDeletedConfigsReq
+	(*ListDeletedConfigsResp)(nil),   // 32: proto.ListDeletedConfigsResp
+	(*RestoreConfigReq)(nil),         // 33: proto.RestoreConfigReq
+	(*RestoreConfigResp)(nil),        // 34: proto.RestoreConfigResp
+	(*MergeConfigsReq)(nil),          // 35: proto.MergeConfigsReq
+	(*MergeConflict)(nil),            // 36: proto.MergeConflict
+	(*MergeConfigsResp)(nil),         // 37: proto.MergeConfigsResp
+	(*DiffProposedReq)(nil),          // 38: proto.DiffProposedReq
+	(*DiffProposedResp)(nil),         // 39: proto.DiffProposedResp
+	(*PatchOp)(nil),                  // 40: proto.PatchOp
+	(*PatchConfigReq)(nil),           // 41: proto.PatchConfigReq
+	nil,                              // 42: proto.DiffConfigGroupResp.DiffsEntry
+	(*PlaceReq_Strategy)(nil),        // 43: proto.PlaceReq.Strategy
+	nil,                              // 44: proto.DiffProposedResp.ConfigGroupDiffsEntry
+	(*api.Selector)(nil),             // 45: proto.Selector
+	(ParamFormat)(0),                 // 46: proto.ParamFormat
+	(*StandaloneConfig)(nil),         // 47: proto.StandaloneConfig
+	(*ConfigId)(nil),                 // 48: proto.ConfigId
+	(*Diff)(nil),                     // 49: proto.Diff
+	(*ConfigGroup)(nil),              // 50: proto.ConfigGroup
+	(*PlacementTask)(nil),            // 51: proto.PlacementTask
+	(*NewStandaloneConfig)(nil),      // 52: proto.NewStandaloneConfig
+	(*NewConfigGroup)(nil),           // 53: proto.NewConfigGroup
+	(*Schema)(nil),                   // 54: proto.Schema
+	(*AuditEvent)(nil),               // 55: proto.AuditEvent
+	(*Param)(nil),                    // 56: proto.Param
+	(*Diffs)(nil),                    // 57: proto.Diffs
+}
+var file_kuiper_proto_depIdxs = []int32{
+	45, // 0: proto.ListFilter.labelSelector:type_name -> proto.Selector
+	0,  // 1: proto.ListStandaloneConfigReq.filter:type_name -> proto.ListFilter
+	1,  // 2: proto.ListStandaloneConfigReq.sort:type_name -> proto.ListSort
+	46, // 3: proto.ListStandaloneConfigReq.paramFormat:type_name -> proto.ParamFormat
+	47, // 4: proto.ListStandaloneConfigResp.configurations:type_name -> proto.StandaloneConfig
+	48, // 5: proto.DiffReq.reference:type_name -> proto.ConfigId
+	48, // 6: proto.DiffReq.diff:type_name -> proto.ConfigId
+	49, // 7: proto.DiffStandaloneConfigResp.diffs:type_name -> proto.Diff
+	0,  // 8: proto.ListConfigGroupReq.filter:type_name -> proto.ListFilter
+	1,  // 9: proto.ListConfigGroupReq.sort:type_name -> proto.ListSort
+	46, // 10: proto.ListConfigGroupReq.paramFormat:type_name -> proto.ParamFormat
+	50, // 11: proto.ListConfigGroupResp.groups:type_name -> proto.ConfigGroup
+	42, // 12: proto.DiffConfigGroupResp.diffs:type_name -> proto.DiffConfigGroupResp.DiffsEntry
+	48, // 13: proto.PlaceReq.config:type_name -> proto.ConfigId
+	43, // 14: proto.PlaceReq.strategy:type_name -> proto.PlaceReq.Strategy
+	51, // 15: proto.PlaceResp.tasks:type_name -> proto.PlacementTask
+	51, // 16: proto.ListPlacementTaskResp.tasks:type_name -> proto.PlacementTask
+	47, // 17: proto.StandaloneConfigEvent.config:type_name -> proto.StandaloneConfig
+	50, // 18: proto.ConfigGroupEvent.config:type_name -> proto.ConfigGroup
+	48, // 19: proto.ImportNamespaceResp.importedStandaloneConfigs:type_name -> proto.ConfigId
+	48, // 20: proto.ImportNamespaceResp.importedConfigGroups:type_name -> proto.ConfigId
+	48, // 21: proto.ImportNamespaceResp.skippedStandaloneConfigs:type_name -> proto.ConfigId
+	48, // 22: proto.ImportNamespaceResp.skippedConfigGroups:type_name -> proto.ConfigId
+	52, // 23: proto.PutBatchReq.standaloneConfigs:type_name -> proto.NewStandaloneConfig
+	53, // 24: proto.PutBatchReq.configGroups:type_name -> proto.NewConfigGroup
+	47, // 25: proto.PutBatchResp.standaloneConfigs:type_name -> proto.StandaloneConfig
+	50, // 26: proto.PutBatchResp.configGroups:type_name -> proto.ConfigGroup
+	20, // 27: proto.PutBatchResp.errors:type_name -> proto.BatchItemError
+	48, // 28: proto.PromoteConfigReq.source:type_name -> proto.ConfigId
+	54, // 29: proto.PromoteConfigReq.schema:type_name -> proto.Schema
+	47, // 30: proto.PromoteConfigResp.standaloneConfig:type_name -> proto.StandaloneConfig
+	50, // 31: proto.PromoteConfigResp.configGroup:type_name -> proto.ConfigGroup
+	48, // 32: proto.ReviewConfigReq.config:type_name -> proto.ConfigId
+	47, // 33: proto.ReviewConfigResp.standaloneConfig:type_name -> proto.StandaloneConfig
+	50, // 34: proto.ReviewConfigResp.configGroup:type_name -> proto.ConfigGroup
+	55, // 35: proto.ListAuditEventsResp.events:type_name -> proto.AuditEvent
+	48, // 36: proto.RetentionCandidate.config:type_name -> proto.ConfigId
+	29, // 37: proto.RetentionReportResp.expired:type_name -> proto.RetentionCandidate
+	29, // 38: proto.RetentionReportResp.kept:type_name -> proto.RetentionCandidate
+	46, // 39: proto.ListDeletedConfigsReq.paramFormat:type_name -> proto.ParamFormat
+	47, // 40: proto.ListDeletedConfigsResp.standaloneConfigs:type_name -> proto.StandaloneConfig
+	50, // 41: proto.ListDeletedConfigsResp.configGroups:type_name -> proto.ConfigGroup
+	48, // 42: proto.RestoreConfigReq.config:type_name -> proto.ConfigId
+	47, // 43: proto.RestoreConfigResp.standaloneConfig:type_name -> proto.StandaloneConfig
+	50, // 44: proto.RestoreConfigResp.configGroup:type_name -> proto.ConfigGroup
+	48, // 45: proto.MergeConfigsReq.ancestor:type_name -> proto.ConfigId
+	48, // 46: proto.MergeConfigsReq.ours:type_name -> proto.ConfigId
+	48, // 47: proto.MergeConfigsReq.theirs:type_name -> proto.ConfigId
+	49, // 48: proto.MergeConflict.ours:type_name -> proto.Diff
+	49, // 49: proto.MergeConflict.theirs:type_name -> proto.Diff
+	47, // 50: proto.MergeConfigsResp.standaloneConfig:type_name -> proto.StandaloneConfig
+	50, // 51: proto.MergeConfigsResp.configGroup:type_name -> proto.ConfigGroup
+	36, // 52: proto.MergeConfigsResp.conflicts:type_name -> proto.MergeConflict
+	52, // 53: proto.DiffProposedReq.standaloneConfig:type_name -> proto.NewStandaloneConfig
+	53, // 54: proto.DiffProposedReq.configGroup:type_name -> proto.NewConfigGroup
+	48, // 55: proto.DiffProposedReq.reference:type_name -> proto.ConfigId
+	49, // 56: proto.DiffProposedResp.standaloneConfigDiffs:type_name -> proto.Diff
+	44, // 57: proto.DiffProposedResp.configGroupDiffs:type_name -> proto.DiffProposedResp.ConfigGroupDiffsEntry
+	56, // 58: proto.PatchOp.param:type_name -> proto.Param
+	48, // 59: proto.PatchConfigReq.base:type_name -> proto.ConfigId
+	40, // 60: proto.PatchConfigReq.ops:type_name -> proto.PatchOp
+	57, // 61: proto.DiffConfigGroupResp.DiffsEntry.value:type_name -> proto.Diffs
+	45, // 62: proto.PlaceReq.Strategy.query:type_name -> proto.Selector
+	57, // 63: proto.DiffProposedResp.ConfigGroupDiffsEntry.value:type_name -> proto.Diffs
+	52, // 64: proto.Kuiper.PutStandaloneConfig:input_type -> proto.NewStandaloneConfig
+	48, // 65: proto.Kuiper.GetStandaloneConfig:input_type -> proto.ConfigId
+	2,  // 66: proto.Kuiper.ListStandaloneConfig:input_type -> proto.ListStandaloneConfigReq
+	48, // 67: proto.Kuiper.DeleteStandaloneConfig:input_type -> proto.ConfigId
+	9,  // 68: proto.Kuiper.PlaceStandaloneConfig:input_type -> proto.PlaceReq
+	48, // 69: proto.Kuiper.ListPlacementTaskByStandaloneConfig:input_type -> proto.ConfigId
+	4,  // 70: proto.Kuiper.DiffStandaloneConfig:input_type -> proto.DiffReq
+	53, // 71: proto.Kuiper.PutConfigGroup:input_type -> proto.NewConfigGroup
+	48, // 72: proto.Kuiper.GetConfigGroup:input_type -> proto.ConfigId
+	6,  // 73: proto.Kuiper.ListConfigGroup:input_type -> proto.ListConfigGroupReq
+	48, // 74: proto.Kuiper.DeleteConfigGroup:input_type -> proto.ConfigId
+	9,  // 75: proto.Kuiper.PlaceConfigGroup:input_type -> proto.PlaceReq
+	48, // 76: proto.Kuiper.ListPlacementTaskByConfigGroup:input_type -> proto.ConfigId
+	4,  // 77: proto.Kuiper.DiffConfigGroup:input_type -> proto.DiffReq
+	12, // 78: proto.Kuiper.WatchStandaloneConfigs:input_type -> proto.WatchReq
+	12, // 79: proto.Kuiper.WatchConfigGroups:input_type -> proto.WatchReq
+	15, // 80: proto.Kuiper.ExportNamespace:input_type -> proto.ExportNamespaceReq
+	17, // 81: proto.Kuiper.ImportNamespace:input_type -> proto.ImportNamespaceReq
+	19, // 82: proto.Kuiper.PutBatch:input_type -> proto.PutBatchReq
+	22, // 83: proto.Kuiper.PromoteConfig:input_type -> proto.PromoteConfigReq
+	24, // 84: proto.Kuiper.ApproveConfig:input_type -> proto.ReviewConfigReq
+	24, // 85: proto.Kuiper.RejectConfig:input_type -> proto.ReviewConfigReq
+	26, // 86: proto.Kuiper.ListAuditEvents:input_type -> proto.ListAuditEventsReq
+	28, // 87: proto.Kuiper.GetRetentionReport:input_type -> proto.RetentionReportReq
+	31, // 88: proto.Kuiper.ListDeletedConfigs:input_type -> proto.ListDeletedConfigsReq
+	33, // 89: proto.Kuiper.RestoreConfig:input_type -> proto.RestoreConfigReq
+	35, // 90: proto.Kuiper.MergeConfigs:input_type -> proto.MergeConfigsReq
+	38, // 91: proto.Kuiper.DiffProposed:input_type -> proto.DiffProposedReq
+	41, // 92: proto.Kuiper.PatchStandaloneConfig:input_type -> proto.PatchConfigReq
+	41, // 93: proto.Kuiper.PatchConfigGroup:input_type -> proto.PatchConfigReq
+	47, // 94: proto.Kuiper.PutStandaloneConfig:output_type -> proto.StandaloneConfig
+	47, // 95: proto.Kuiper.GetStandaloneConfig:output_type -> proto.StandaloneConfig
+	3,  // 96: proto.Kuiper.ListStandaloneConfig:output_type -> proto.ListStandaloneConfigResp
+	47, // 97: proto.Kuiper.DeleteStandaloneConfig:output_type -> proto.StandaloneConfig
+	10, // 98: proto.Kuiper.PlaceStandaloneConfig:output_type -> proto.PlaceResp
+	11, // 99: proto.Kuiper.ListPlacementTaskByStandaloneConfig:output_type -> proto.ListPlacementTaskResp
+	5,  // 100: proto.Kuiper.DiffStandaloneConfig:output_type -> proto.DiffStandaloneConfigResp
+	50, // 101: proto.Kuiper.PutConfigGroup:output_type -> proto.ConfigGroup
+	50, // 102: proto.Kuiper.GetConfigGroup:output_type -> proto.ConfigGroup
+	7,  // 103: proto.Kuiper.ListConfigGroup:output_type -> proto.ListConfigGroupResp
+	50, // 104: proto.Kuiper.DeleteConfigGroup:output_type -> proto.ConfigGroup
+	10, // 105: proto.Kuiper.PlaceConfigGroup:output_type -> proto.PlaceResp
+	11, // 106: proto.Kuiper.ListPlacementTaskByConfigGroup:output_type -> proto.ListPlacementTaskResp
+	8,  // 107: proto.Kuiper.DiffConfigGroup:output_type -> proto.DiffConfigGroupResp
+	13, // 108: proto.Kuiper.WatchStandaloneConfigs:output_type -> proto.StandaloneConfigEvent
+	14, // 109: proto.Kuiper.WatchConfigGroups:output_type -> proto.ConfigGroupEvent
+	16, // 110: proto.Kuiper.ExportNamespace:output_type -> proto.ExportNamespaceResp
+	18, // 111: proto.Kuiper.ImportNamespace:output_type -> proto.ImportNamespaceResp
+	21, // 112: proto.Kuiper.PutBatch:output_type -> proto.PutBatchResp
+	23, // 113: proto.Kuiper.PromoteConfig:output_type -> proto.PromoteConfigResp
+	25, // 114: proto.Kuiper.ApproveConfig:output_type -> proto.ReviewConfigResp
+	25, // 115: proto.Kuiper.RejectConfig:output_type -> proto.ReviewConfigResp
+	27, // 116: proto.Kuiper.ListAuditEvents:output_type -> proto.ListAuditEventsResp
+	30, // 117: proto.Kuiper.GetRetentionReport:output_type -> proto.RetentionReportResp
+	32, // 118: proto.Kuiper.ListDeletedConfigs:output_type -> proto.ListDeletedConfigsResp
+	34, // 119: proto.Kuiper.RestoreConfig:output_type -> proto.RestoreConfigResp
+	37, // 120: proto.Kuiper.MergeConfigs:output_type -> proto.MergeConfigsResp
+	39, // 121: proto.Kuiper.DiffProposed:output_type -> proto.DiffProposedResp
+	47, // 122: proto.Kuiper.PatchStandaloneConfig:output_type -> proto.StandaloneConfig
+	50, // 123: proto.Kuiper.PatchConfigGroup:output_type -> proto.ConfigGroup
+	94, // [94:124] is the sub-list for method output_type
+	64, // [64:94] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
+}
+
+func init() { file_kuiper_proto_init() }
+func file_kuiper_proto_init() {
+	if File_kuiper_proto != nil {
+		return
+	}
+	file_kuiper_model_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_kuiper_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStandaloneConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStandaloneConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffStandaloneConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConfigGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConfigGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffConfigGroupResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlacementTaskResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StandaloneConfigEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigGroupEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportNamespaceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportNamespaceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportNamespaceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportNamespaceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutBatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchItemError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutBatchResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PlaceReq_Strategy.ProtoReflect.Descriptor instead.
-func (*PlaceReq_Strategy) Descriptor() ([]byte, []int) {
-	return file_kuiper_proto_rawDescGZIP(), []int{7, 0}
-}
-
-func (x *PlaceReq_Strategy) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *PlaceReq_Strategy) GetQuery() []*api.Selector {
-	if x != nil {
-		return x.Query
-	}
-	return nil
-}
-
-func (x *PlaceReq_Strategy) GetPercentage() int32 {
-	if x != nil {
-		return x.Percentage
-	}
-	return 0
-}
-
-var File_kuiper_proto protoreflect.FileDescriptor
-
-var file_kuiper_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x6b, 0x75, 0x69, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6b, 0x75, 0x69, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x6d, 0x61, 0x67, 0x6e, 0x65,
-	0x74, 0x61, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5b, 0x0a, 0x17, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x5b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x5d, 0x0a, 0x07, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x12, 0x2d,
-	0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x49, 0x64, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a,
-	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x04, 0x64, 0x69,
-	0x66, 0x66, 0x22, 0x3d, 0x0a, 0x18, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61,
-	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x21,
-	0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66,
-	0x73, 0x22, 0x56, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x41, 0x0a, 0x13, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x2a, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x9a, 0x01, 0x0a,
-	0x13, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x3b, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e,
-	0x44, 0x69, 0x66, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66,
-	0x73, 0x1a, 0x46, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x73, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x01, 0x0a, 0x08, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x34, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x1a, 0x65, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x37, 0x0a, 0x09,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x61, 0x73,
-	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05,
-	0x74, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x43, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2a,
-	0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x32, 0xe8, 0x07, 0x0a, 0x06, 0x4b,
-	0x75, 0x69, 0x70, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x13, 0x50, 0x75, 0x74, 0x53, 0x74, 0x61, 0x6e,
-	0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f,
-	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61,
-	0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x17, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
-	0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64,
-	0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64,
-	0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x44, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64,
-	0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x17, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x53, 0x74, 0x61, 0x6e,
-	0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x1c, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x14, 0x44, 0x69, 0x66, 0x66, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
-	0x66, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
-	0x66, 0x66, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x6c, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x64, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00,
-	0x12, 0x4a, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x49, 0x64, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x51, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x49, 0x64, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0f, 0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x69, 0x66, 0x66, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_kuiper_proto_rawDescOnce sync.Once
-	file_kuiper_proto_rawDescData = file_kuiper_proto_rawDesc
-)
-
-func file_kuiper_proto_rawDescGZIP() []byte {
-	file_kuiper_proto_rawDescOnce.Do(func() {
-		file_kuiper_proto_rawDescData = protoimpl.X.CompressGZIP(file_kuiper_proto_rawDescData)
-	})
-	return file_kuiper_proto_rawDescData
-}
-
-var file_kuiper_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_kuiper_proto_goTypes = []interface{}{
-	(*ListStandaloneConfigReq)(nil),  // 0: proto.ListStandaloneConfigReq
-	(*ListStandaloneConfigResp)(nil), // 1: proto.ListStandaloneConfigResp
-	(*DiffReq)(nil),                  // 2: proto.DiffReq
-	(*DiffStandaloneConfigResp)(nil), // 3: proto.DiffStandaloneConfigResp
-	(*ListConfigGroupReq)(nil),       // 4: proto.ListConfigGroupReq
-	(*ListConfigGroupResp)(nil),      // 5: proto.ListConfigGroupResp
-	(*DiffConfigGroupResp)(nil),      // 6: proto.DiffConfigGroupResp
-	(*PlaceReq)(nil),                 // 7: proto.PlaceReq
-	(*PlaceResp)(nil),                // 8: proto.PlaceResp
-	(*ListPlacementTaskResp)(nil),    // 9: proto.ListPlacementTaskResp
-	nil,                              // 10: proto.DiffConfigGroupResp.DiffsEntry
-	(*PlaceReq_Strategy)(nil),        // 11: proto.PlaceReq.Strategy
-	(*StandaloneConfig)(nil),         // 12: proto.StandaloneConfig
-	(*ConfigId)(nil),                 // 13: proto.ConfigId
-	(*Diff)(nil),                     // 14: proto.Diff
-	(*ConfigGroup)(nil),              // 15: proto.ConfigGroup
-	(*PlacementTask)(nil),            // 16: proto.PlacementTask
-	(*Diffs)(nil),                    // 17: proto.Diffs
-	(*api.Selector)(nil),             // 18: proto.Selector
-	(*NewStandaloneConfig)(nil),      // 19: proto.NewStandaloneConfig
-	(*NewConfigGroup)(nil),           // 20: proto.NewConfigGroup
-}
-var file_kuiper_proto_depIdxs = []int32{
-	12, // 0: proto.ListStandaloneConfigResp.configurations:type_name -> proto.StandaloneConfig
-	13, // 1: proto.DiffReq.reference:type_name -> proto.ConfigId
-	13, // 2: proto.DiffReq.diff:type_name -> proto.ConfigId
-	14, // 3: proto.DiffStandaloneConfigResp.diffs:type_name -> proto.Diff
-	15, // 4: proto.ListConfigGroupResp.groups:type_name -> proto.ConfigGroup
-	10, // 5: proto.DiffConfigGroupResp.diffs:type_name -> proto.DiffConfigGroupResp.DiffsEntry
-	13, // 6: proto.PlaceReq.config:type_name -> proto.ConfigId
-	11, // 7: proto.PlaceReq.strategy:type_name -> proto.PlaceReq.Strategy
-	16, // 8: proto.PlaceResp.tasks:type_name -> proto.PlacementTask
-	16, // 9: proto.ListPlacementTaskResp.tasks:type_name -> proto.PlacementTask
-	17, // 10: proto.DiffConfigGroupResp.DiffsEntry.value:type_name -> proto.Diffs
-	18, // 11: proto.PlaceReq.Strategy.query:type_name -> proto.Selector
-	19, // 12: proto.Kuiper.PutStandaloneConfig:input_type -> proto.NewStandaloneConfig
-	13, // 13: proto.Kuiper.GetStandaloneConfig:input_type -> proto.ConfigId
-	0,  // 14: proto.Kuiper.ListStandaloneConfig:input_type -> proto.ListStandaloneConfigReq
-	13, // 15: proto.Kuiper.DeleteStandaloneConfig:input_type -> proto.ConfigId
-	7,  // 16: proto.Kuiper.PlaceStandaloneConfig:input_type -> proto.PlaceReq
-	13, // 17: proto.Kuiper.ListPlacementTaskByStandaloneConfig:input_type -> proto.ConfigId
-	2,  // 18: proto.Kuiper.DiffStandaloneConfig:input_type -> proto.DiffReq
-	20, // 19: proto.Kuiper.PutConfigGroup:input_type -> proto.NewConfigGroup
-	13, // 20: proto.Kuiper.GetConfigGroup:input_type -> proto.ConfigId
-	4,  // 21: proto.Kuiper.ListConfigGroup:input_type -> proto.ListConfigGroupReq
-	13, // 22: proto.Kuiper.DeleteConfigGroup:input_type -> proto.ConfigId
-	7,  // 23: proto.Kuiper.PlaceConfigGroup:input_type -> proto.PlaceReq
-	13, // 24: proto.Kuiper.ListPlacementTaskByConfigGroup:input_type -> proto.ConfigId
-	2,  // 25: proto.Kuiper.DiffConfigGroup:input_type -> proto.DiffReq
-	12, // 26: proto.Kuiper.PutStandaloneConfig:output_type -> proto.StandaloneConfig
-	12, // 27: proto.Kuiper.GetStandaloneConfig:output_type -> proto.StandaloneConfig
-	1,  // 28: proto.Kuiper.ListStandaloneConfig:output_type -> proto.ListStandaloneConfigResp
-	12, // 29: proto.Kuiper.DeleteStandaloneConfig:output_type -> proto.StandaloneConfig
-	8,  // 30: proto.Kuiper.PlaceStandaloneConfig:output_type -> proto.PlaceResp
-	9,  // 31: proto.Kuiper.ListPlacementTaskByStandaloneConfig:output_type -> proto.ListPlacementTaskResp
-	3,  // 32: proto.Kuiper.DiffStandaloneConfig:output_type -> proto.DiffStandaloneConfigResp
-	15, // 33: proto.Kuiper.PutConfigGroup:output_type -> proto.ConfigGroup
-	15, // 34: proto.Kuiper.GetConfigGroup:output_type -> proto.ConfigGroup
-	5,  // 35: proto.Kuiper.ListConfigGroup:output_type -> proto.ListConfigGroupResp
-	15, // 36: proto.Kuiper.DeleteConfigGroup:output_type -> proto.ConfigGroup
-	8,  // 37: proto.Kuiper.PlaceConfigGroup:output_type -> proto.PlaceResp
-	9,  // 38: proto.Kuiper.ListPlacementTaskByConfigGroup:output_type -> proto.ListPlacementTaskResp
-	6,  // 39: proto.Kuiper.DiffConfigGroup:output_type -> proto.DiffConfigGroupResp
-	26, // [26:40] is the sub-list for method output_type
-	12, // [12:26] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
-}
-
-func init() { file_kuiper_proto_init() }
-func file_kuiper_proto_init() {
-	if File_kuiper_proto != nil {
-		return
-	}
-	file_kuiper_model_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_kuiper_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStandaloneConfigReq); i {
+		file_kuiper_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteConfigReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -823,8 +3996,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStandaloneConfigResp); i {
+		file_kuiper_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromoteConfigResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -835,8 +4008,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffReq); i {
+		file_kuiper_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewConfigReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -847,8 +4020,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffStandaloneConfigResp); i {
+		file_kuiper_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewConfigResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -859,8 +4032,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListConfigGroupReq); i {
+		file_kuiper_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -871,8 +4044,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListConfigGroupResp); i {
+		file_kuiper_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -883,8 +4056,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiffConfigGroupResp); i {
+		file_kuiper_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionReportReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -895,8 +4068,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaceReq); i {
+		file_kuiper_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionCandidate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -907,8 +4080,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlaceResp); i {
+		file_kuiper_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionReportResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -919,8 +4092,8 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPlacementTaskResp); i {
+		file_kuiper_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedConfigsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -931,7 +4104,127 @@ func file_kuiper_proto_init() {
 				return nil
 			}
 		}
-		file_kuiper_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_kuiper_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedConfigsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeConfigsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeConfigsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffProposedReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffProposedResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kuiper_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PlaceReq_Strategy); i {
 			case 0:
 				return &v.state
@@ -950,7 +4243,7 @@ func file_kuiper_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kuiper_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
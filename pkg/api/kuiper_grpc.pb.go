@@ -36,6 +36,22 @@ type KuiperClient interface {
 	PlaceConfigGroup(ctx context.Context, in *PlaceReq, opts ...grpc.CallOption) (*PlaceResp, error)
 	ListPlacementTaskByConfigGroup(ctx context.Context, in *ConfigId, opts ...grpc.CallOption) (*ListPlacementTaskResp, error)
 	DiffConfigGroup(ctx context.Context, in *DiffReq, opts ...grpc.CallOption) (*DiffConfigGroupResp, error)
+	WatchStandaloneConfigs(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (Kuiper_WatchStandaloneConfigsClient, error)
+	WatchConfigGroups(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (Kuiper_WatchConfigGroupsClient, error)
+	ExportNamespace(ctx context.Context, in *ExportNamespaceReq, opts ...grpc.CallOption) (*ExportNamespaceResp, error)
+	ImportNamespace(ctx context.Context, in *ImportNamespaceReq, opts ...grpc.CallOption) (*ImportNamespaceResp, error)
+	PutBatch(ctx context.Context, in *PutBatchReq, opts ...grpc.CallOption) (*PutBatchResp, error)
+	PromoteConfig(ctx context.Context, in *PromoteConfigReq, opts ...grpc.CallOption) (*PromoteConfigResp, error)
+	ApproveConfig(ctx context.Context, in *ReviewConfigReq, opts ...grpc.CallOption) (*ReviewConfigResp, error)
+	RejectConfig(ctx context.Context, in *ReviewConfigReq, opts ...grpc.CallOption) (*ReviewConfigResp, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsReq, opts ...grpc.CallOption) (*ListAuditEventsResp, error)
+	GetRetentionReport(ctx context.Context, in *RetentionReportReq, opts ...grpc.CallOption) (*RetentionReportResp, error)
+	ListDeletedConfigs(ctx context.Context, in *ListDeletedConfigsReq, opts ...grpc.CallOption) (*ListDeletedConfigsResp, error)
+	RestoreConfig(ctx context.Context, in *RestoreConfigReq, opts ...grpc.CallOption) (*RestoreConfigResp, error)
+	MergeConfigs(ctx context.Context, in *MergeConfigsReq, opts ...grpc.CallOption) (*MergeConfigsResp, error)
+	DiffProposed(ctx context.Context, in *DiffProposedReq, opts ...grpc.CallOption) (*DiffProposedResp, error)
+	PatchStandaloneConfig(ctx context.Context, in *PatchConfigReq, opts ...grpc.CallOption) (*StandaloneConfig, error)
+	PatchConfigGroup(ctx context.Context, in *PatchConfigReq, opts ...grpc.CallOption) (*ConfigGroup, error)
 }
 
 type kuiperClient struct {
@@ -172,6 +188,196 @@ func (c *kuiperClient) DiffConfigGroup(ctx context.Context, in *DiffReq, opts ..
 	return out, nil
 }
 
+func (c *kuiperClient) WatchStandaloneConfigs(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (Kuiper_WatchStandaloneConfigsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Kuiper_ServiceDesc.Streams[0], "/proto.Kuiper/WatchStandaloneConfigs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kuiperWatchStandaloneConfigsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Kuiper_WatchStandaloneConfigsClient interface {
+	Recv() (*StandaloneConfigEvent, error)
+	grpc.ClientStream
+}
+
+type kuiperWatchStandaloneConfigsClient struct {
+	grpc.ClientStream
+}
+
+func (x *kuiperWatchStandaloneConfigsClient) Recv() (*StandaloneConfigEvent, error) {
+	m := new(StandaloneConfigEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kuiperClient) WatchConfigGroups(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (Kuiper_WatchConfigGroupsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Kuiper_ServiceDesc.Streams[1], "/proto.Kuiper/WatchConfigGroups", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &kuiperWatchConfigGroupsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Kuiper_WatchConfigGroupsClient interface {
+	Recv() (*ConfigGroupEvent, error)
+	grpc.ClientStream
+}
+
+type kuiperWatchConfigGroupsClient struct {
+	grpc.ClientStream
+}
+
+func (x *kuiperWatchConfigGroupsClient) Recv() (*ConfigGroupEvent, error) {
+	m := new(ConfigGroupEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *kuiperClient) ExportNamespace(ctx context.Context, in *ExportNamespaceReq, opts ...grpc.CallOption) (*ExportNamespaceResp, error) {
+	out := new(ExportNamespaceResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ExportNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ImportNamespace(ctx context.Context, in *ImportNamespaceReq, opts ...grpc.CallOption) (*ImportNamespaceResp, error) {
+	out := new(ImportNamespaceResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ImportNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) PutBatch(ctx context.Context, in *PutBatchReq, opts ...grpc.CallOption) (*PutBatchResp, error) {
+	out := new(PutBatchResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/PutBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) PromoteConfig(ctx context.Context, in *PromoteConfigReq, opts ...grpc.CallOption) (*PromoteConfigResp, error) {
+	out := new(PromoteConfigResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/PromoteConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ApproveConfig(ctx context.Context, in *ReviewConfigReq, opts ...grpc.CallOption) (*ReviewConfigResp, error) {
+	out := new(ReviewConfigResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ApproveConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) RejectConfig(ctx context.Context, in *ReviewConfigReq, opts ...grpc.CallOption) (*ReviewConfigResp, error) {
+	out := new(ReviewConfigResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/RejectConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsReq, opts ...grpc.CallOption) (*ListAuditEventsResp, error) {
+	out := new(ListAuditEventsResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) GetRetentionReport(ctx context.Context, in *RetentionReportReq, opts ...grpc.CallOption) (*RetentionReportResp, error) {
+	out := new(RetentionReportResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/GetRetentionReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) ListDeletedConfigs(ctx context.Context, in *ListDeletedConfigsReq, opts ...grpc.CallOption) (*ListDeletedConfigsResp, error) {
+	out := new(ListDeletedConfigsResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/ListDeletedConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) RestoreConfig(ctx context.Context, in *RestoreConfigReq, opts ...grpc.CallOption) (*RestoreConfigResp, error) {
+	out := new(RestoreConfigResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/RestoreConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) MergeConfigs(ctx context.Context, in *MergeConfigsReq, opts ...grpc.CallOption) (*MergeConfigsResp, error) {
+	out := new(MergeConfigsResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/MergeConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) DiffProposed(ctx context.Context, in *DiffProposedReq, opts ...grpc.CallOption) (*DiffProposedResp, error) {
+	out := new(DiffProposedResp)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/DiffProposed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) PatchStandaloneConfig(ctx context.Context, in *PatchConfigReq, opts ...grpc.CallOption) (*StandaloneConfig, error) {
+	out := new(StandaloneConfig)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/PatchStandaloneConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kuiperClient) PatchConfigGroup(ctx context.Context, in *PatchConfigReq, opts ...grpc.CallOption) (*ConfigGroup, error) {
+	out := new(ConfigGroup)
+	err := c.cc.Invoke(ctx, "/proto.Kuiper/PatchConfigGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KuiperServer is the server API for Kuiper service.
 // All implementations must embed UnimplementedKuiperServer
 // for forward compatibility
@@ -190,6 +396,22 @@ type KuiperServer interface {
 	PlaceConfigGroup(context.Context, *PlaceReq) (*PlaceResp, error)
 	ListPlacementTaskByConfigGroup(context.Context, *ConfigId) (*ListPlacementTaskResp, error)
 	DiffConfigGroup(context.Context, *DiffReq) (*DiffConfigGroupResp, error)
+	WatchStandaloneConfigs(*WatchReq, Kuiper_WatchStandaloneConfigsServer) error
+	WatchConfigGroups(*WatchReq, Kuiper_WatchConfigGroupsServer) error
+	ExportNamespace(context.Context, *ExportNamespaceReq) (*ExportNamespaceResp, error)
+	ImportNamespace(context.Context, *ImportNamespaceReq) (*ImportNamespaceResp, error)
+	PutBatch(context.Context, *PutBatchReq) (*PutBatchResp, error)
+	PromoteConfig(context.Context, *PromoteConfigReq) (*PromoteConfigResp, error)
+	ApproveConfig(context.Context, *ReviewConfigReq) (*ReviewConfigResp, error)
+	RejectConfig(context.Context, *ReviewConfigReq) (*ReviewConfigResp, error)
+	ListAuditEvents(context.Context, *ListAuditEventsReq) (*ListAuditEventsResp, error)
+	GetRetentionReport(context.Context, *RetentionReportReq) (*RetentionReportResp, error)
+	ListDeletedConfigs(context.Context, *ListDeletedConfigsReq) (*ListDeletedConfigsResp, error)
+	RestoreConfig(context.Context, *RestoreConfigReq) (*RestoreConfigResp, error)
+	MergeConfigs(context.Context, *MergeConfigsReq) (*MergeConfigsResp, error)
+	DiffProposed(context.Context, *DiffProposedReq) (*DiffProposedResp, error)
+	PatchStandaloneConfig(context.Context, *PatchConfigReq) (*StandaloneConfig, error)
+	PatchConfigGroup(context.Context, *PatchConfigReq) (*ConfigGroup, error)
 	mustEmbedUnimplementedKuiperServer()
 }
 
@@ -239,6 +461,54 @@ func (UnimplementedKuiperServer) ListPlacementTaskByConfigGroup(context.Context,
 func (UnimplementedKuiperServer) DiffConfigGroup(context.Context, *DiffReq) (*DiffConfigGroupResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiffConfigGroup not implemented")
 }
+func (UnimplementedKuiperServer) WatchStandaloneConfigs(*WatchReq, Kuiper_WatchStandaloneConfigsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStandaloneConfigs not implemented")
+}
+func (UnimplementedKuiperServer) WatchConfigGroups(*WatchReq, Kuiper_WatchConfigGroupsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConfigGroups not implemented")
+}
+func (UnimplementedKuiperServer) ExportNamespace(context.Context, *ExportNamespaceReq) (*ExportNamespaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportNamespace not implemented")
+}
+func (UnimplementedKuiperServer) ImportNamespace(context.Context, *ImportNamespaceReq) (*ImportNamespaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportNamespace not implemented")
+}
+func (UnimplementedKuiperServer) PutBatch(context.Context, *PutBatchReq) (*PutBatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutBatch not implemented")
+}
+func (UnimplementedKuiperServer) PromoteConfig(context.Context, *PromoteConfigReq) (*PromoteConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteConfig not implemented")
+}
+func (UnimplementedKuiperServer) ApproveConfig(context.Context, *ReviewConfigReq) (*ReviewConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveConfig not implemented")
+}
+func (UnimplementedKuiperServer) RejectConfig(context.Context, *ReviewConfigReq) (*ReviewConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectConfig not implemented")
+}
+func (UnimplementedKuiperServer) ListAuditEvents(context.Context, *ListAuditEventsReq) (*ListAuditEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedKuiperServer) GetRetentionReport(context.Context, *RetentionReportReq) (*RetentionReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionReport not implemented")
+}
+func (UnimplementedKuiperServer) ListDeletedConfigs(context.Context, *ListDeletedConfigsReq) (*ListDeletedConfigsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedConfigs not implemented")
+}
+func (UnimplementedKuiperServer) RestoreConfig(context.Context, *RestoreConfigReq) (*RestoreConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreConfig not implemented")
+}
+func (UnimplementedKuiperServer) MergeConfigs(context.Context, *MergeConfigsReq) (*MergeConfigsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeConfigs not implemented")
+}
+func (UnimplementedKuiperServer) DiffProposed(context.Context, *DiffProposedReq) (*DiffProposedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffProposed not implemented")
+}
+func (UnimplementedKuiperServer) PatchStandaloneConfig(context.Context, *PatchConfigReq) (*StandaloneConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchStandaloneConfig not implemented")
+}
+func (UnimplementedKuiperServer) PatchConfigGroup(context.Context, *PatchConfigReq) (*ConfigGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchConfigGroup not implemented")
+}
 func (UnimplementedKuiperServer) mustEmbedUnimplementedKuiperServer() {}
 
 // UnsafeKuiperServer may be embedded to opt out of forward compatibility for this service.
@@ -504,6 +774,300 @@ func _Kuiper_DiffConfigGroup_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Kuiper_WatchStandaloneConfigs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KuiperServer).WatchStandaloneConfigs(m, &kuiperWatchStandaloneConfigsServer{stream})
+}
+
+type Kuiper_WatchStandaloneConfigsServer interface {
+	Send(*StandaloneConfigEvent) error
+	grpc.ServerStream
+}
+
+type kuiperWatchStandaloneConfigsServer struct {
+	grpc.ServerStream
+}
+
+func (x *kuiperWatchStandaloneConfigsServer) Send(m *StandaloneConfigEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Kuiper_WatchConfigGroups_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KuiperServer).WatchConfigGroups(m, &kuiperWatchConfigGroupsServer{stream})
+}
+
+type Kuiper_WatchConfigGroupsServer interface {
+	Send(*ConfigGroupEvent) error
+	grpc.ServerStream
+}
+
+type kuiperWatchConfigGroupsServer struct {
+	grpc.ServerStream
+}
+
+func (x *kuiperWatchConfigGroupsServer) Send(m *ConfigGroupEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Kuiper_ExportNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportNamespaceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ExportNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ExportNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ExportNamespace(ctx, req.(*ExportNamespaceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ImportNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportNamespaceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ImportNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ImportNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ImportNamespace(ctx, req.(*ImportNamespaceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_PutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).PutBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/PutBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).PutBatch(ctx, req.(*PutBatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_PromoteConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).PromoteConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/PromoteConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).PromoteConfig(ctx, req.(*PromoteConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ApproveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ApproveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ApproveConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ApproveConfig(ctx, req.(*ReviewConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_RejectConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).RejectConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/RejectConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).RejectConfig(ctx, req.(*ReviewConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListAuditEvents(ctx, req.(*ListAuditEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_GetRetentionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetentionReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).GetRetentionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/GetRetentionReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).GetRetentionReport(ctx, req.(*RetentionReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_ListDeletedConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedConfigsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).ListDeletedConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/ListDeletedConfigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).ListDeletedConfigs(ctx, req.(*ListDeletedConfigsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_RestoreConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).RestoreConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/RestoreConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).RestoreConfig(ctx, req.(*RestoreConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_MergeConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeConfigsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).MergeConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/MergeConfigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).MergeConfigs(ctx, req.(*MergeConfigsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_DiffProposed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffProposedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).DiffProposed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/DiffProposed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).DiffProposed(ctx, req.(*DiffProposedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_PatchStandaloneConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).PatchStandaloneConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/PatchStandaloneConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).PatchStandaloneConfig(ctx, req.(*PatchConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Kuiper_PatchConfigGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KuiperServer).PatchConfigGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Kuiper/PatchConfigGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KuiperServer).PatchConfigGroup(ctx, req.(*PatchConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Kuiper_ServiceDesc is the grpc.ServiceDesc for Kuiper service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -567,7 +1131,74 @@ var Kuiper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DiffConfigGroup",
 			Handler:    _Kuiper_DiffConfigGroup_Handler,
 		},
+		{
+			MethodName: "ExportNamespace",
+			Handler:    _Kuiper_ExportNamespace_Handler,
+		},
+		{
+			MethodName: "ImportNamespace",
+			Handler:    _Kuiper_ImportNamespace_Handler,
+		},
+		{
+			MethodName: "PutBatch",
+			Handler:    _Kuiper_PutBatch_Handler,
+		},
+		{
+			MethodName: "PromoteConfig",
+			Handler:    _Kuiper_PromoteConfig_Handler,
+		},
+		{
+			MethodName: "ApproveConfig",
+			Handler:    _Kuiper_ApproveConfig_Handler,
+		},
+		{
+			MethodName: "RejectConfig",
+			Handler:    _Kuiper_RejectConfig_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Kuiper_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetRetentionReport",
+			Handler:    _Kuiper_GetRetentionReport_Handler,
+		},
+		{
+			MethodName: "ListDeletedConfigs",
+			Handler:    _Kuiper_ListDeletedConfigs_Handler,
+		},
+		{
+			MethodName: "RestoreConfig",
+			Handler:    _Kuiper_RestoreConfig_Handler,
+		},
+		{
+			MethodName: "MergeConfigs",
+			Handler:    _Kuiper_MergeConfigs_Handler,
+		},
+		{
+			MethodName: "DiffProposed",
+			Handler:    _Kuiper_DiffProposed_Handler,
+		},
+		{
+			MethodName: "PatchStandaloneConfig",
+			Handler:    _Kuiper_PatchStandaloneConfig_Handler,
+		},
+		{
+			MethodName: "PatchConfigGroup",
+			Handler:    _Kuiper_PatchConfigGroup_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStandaloneConfigs",
+			Handler:       _Kuiper_WatchStandaloneConfigs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchConfigGroups",
+			Handler:       _Kuiper_WatchConfigGroups_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "kuiper.proto",
 }